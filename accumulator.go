@@ -0,0 +1,76 @@
+package money
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Accumulator keeps a running total per currency and is safe for concurrent use.
+// Adding is lock free: every currency has its own int64 updated with compare-and-swap,
+// a sum that would overflow is rejected and leaves the total untouched.
+// The zero value is ready to use, an Accumulator must not be copied after first use.
+type Accumulator struct {
+	totals sync.Map // currency code -> *accumulatorTotal
+}
+
+type accumulatorTotal struct {
+	amount   int64
+	currency Currency
+}
+
+// Add adds m to the total of its currency, it fails with ErrOverflow when the total would overflow
+func (a *Accumulator) Add(m Money) error {
+	code := strings.ToUpper(m.Currency.Code)
+	// LoadOrStore alone would allocate a new total on every call
+	v, ok := a.totals.Load(code)
+	if !ok {
+		v, _ = a.totals.LoadOrStore(code, &accumulatorTotal{currency: m.Currency})
+	}
+	t := v.(*accumulatorTotal)
+	if !t.currency.IsEquals(m.Currency) {
+		return &CurrencyMismatchError{Left: t.currency, Right: m.Currency}
+	}
+
+	for {
+		old := atomic.LoadInt64(&t.amount)
+		sum, err := addInt64(old, m.Amount.Int64())
+		if err != nil {
+			return err
+		}
+		if atomic.CompareAndSwapInt64(&t.amount, old, sum) {
+			return nil
+		}
+	}
+}
+
+func (a *Accumulator) MustAdd(m Money) {
+	if err := a.Add(m); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the current total of a currency, false when nothing was added in that currency.
+// The code is case insensitive like in MultiMoney.Get
+func (a *Accumulator) Get(code string) (m Money, ok bool) {
+	v, ok := a.totals.Load(strings.ToUpper(code))
+	if !ok {
+		return m, false
+	}
+	t := v.(*accumulatorTotal)
+
+	return ForgeWellFormed(atomic.LoadInt64(&t.amount), t.currency), true
+}
+
+// Snapshot returns the totals by currency code, every total is read atomically
+// but adds running at the same time may be seen only for some currencies
+func (a *Accumulator) Snapshot() map[string]Money {
+	s := map[string]Money{}
+	a.totals.Range(func(k, v interface{}) bool {
+		t := v.(*accumulatorTotal)
+		s[k.(string)] = ForgeWellFormed(atomic.LoadInt64(&t.amount), t.currency)
+		return true
+	})
+
+	return s
+}
@@ -0,0 +1,65 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator_Concurrent(t *testing.T) {
+	var acc money.Accumulator
+
+	wg := sync.WaitGroup{}
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				acc.MustAdd(money.EUR(1))
+				acc.MustAdd(money.JPY(2))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string]money.Money{
+		"EUR": money.EUR(8000),
+		"JPY": money.JPY(16000),
+	}, acc.Snapshot())
+
+	eur, ok := acc.Get("EUR")
+	assert.True(t, ok)
+	assert.Equal(t, money.EUR(8000), eur)
+
+	eur, ok = acc.Get("eur")
+	assert.True(t, ok)
+	assert.Equal(t, money.EUR(8000), eur)
+
+	_, ok = acc.Get("USD")
+	assert.False(t, ok)
+}
+
+func TestAccumulator_AddDoesNotAllocate(t *testing.T) {
+	var acc money.Accumulator
+	acc.MustAdd(money.EUR(1))
+
+	eur := money.EUR(1)
+	allocs := testing.AllocsPerRun(100, func() { acc.MustAdd(eur) })
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestAccumulator_Overflow(t *testing.T) {
+	var acc money.Accumulator
+
+	assert.Nil(t, acc.Add(money.EUR(math.MaxInt64)))
+	assert.True(t, errors.Is(acc.Add(money.EUR(1)), money.ErrOverflow))
+
+	eur, _ := acc.Get("EUR")
+	assert.Equal(t, money.EUR(math.MaxInt64), eur)
+
+	assert.Nil(t, acc.Add(money.EUR(-1)))
+}
@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// checkSameCurrency fails with ErrEmpty on an empty slice and with CurrencyMismatchError
+// on the first money with a currency different from the first one
+func checkSameCurrency(ms []Money) error {
+	if len(ms) == 0 {
+		return ErrEmpty
+	}
+	for _, m := range ms[1:] {
+		if !ms[0].Currency.IsEquals(m.Currency) {
+			return &CurrencyMismatchError{Left: ms[0].Currency, Right: m.Currency}
+		}
+	}
+
+	return nil
+}
+
+// Sum returns the sum of all the moneys, they must have the same currency
+func Sum(ms []Money) (s Money, err error) {
+	if err = checkSameCurrency(ms); err != nil {
+		return s, err
+	}
+
+	s = ms[0]
+	for _, m := range ms[1:] {
+		s, err = s.Add(m)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+
+	return s, err
+}
+
+// Average returns the arithmetic mean rounded with mode, the intermediate sum can't overflow
+func Average(ms []Money, mode RoundingMode) (avg Money, err error) {
+	if err = checkSameCurrency(ms); err != nil {
+		return avg, err
+	}
+
+	sum := new(big.Int)
+	for _, m := range ms {
+		sum.Add(sum, big.NewInt(m.Amount.Int64()))
+	}
+	q := quoRound(sum, big.NewInt(int64(len(ms))), mode)
+
+	return ForgeWellFormed(q.Int64(), ms[0].Currency), err
+}
+
+// Median returns the middle money, with an even count it's the average of the two middle ones rounded with mode
+func Median(ms []Money, mode RoundingMode) (median Money, err error) {
+	sorted, err := sortedCopy(ms)
+	if err != nil {
+		return median, err
+	}
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2], err
+	}
+
+	return Average(sorted[n/2-1:n/2+1], mode)
+}
+
+// Percentile returns the p-th percentile (0-100) using the nearest-rank method,
+// the result is always one of the given moneys so no rounding is involved
+func Percentile(ms []Money, p int) (pm Money, err error) {
+	if p < 0 || p > 100 {
+		return pm, errors.New("percentile must be between 0 and 100")
+	}
+	sorted, err := sortedCopy(ms)
+	if err != nil {
+		return pm, err
+	}
+
+	// rank = ceil(p/100 * n), the 0-th percentile is the smallest value
+	rank := (p*len(sorted) + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+
+	return sorted[rank-1], err
+}
+
+// SortMoney sorts the moneys in ascending order in place, they must have the same currency
+func SortMoney(ms []Money) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	if err := checkSameCurrency(ms); err != nil {
+		return err
+	}
+
+	sort.SliceStable(ms, func(i, j int) bool {
+		return ms[i].Amount < ms[j].Amount
+	})
+
+	return nil
+}
+
+func sortedCopy(ms []Money) ([]Money, error) {
+	if err := checkSameCurrency(ms); err != nil {
+		return nil, err
+	}
+
+	sorted := make([]Money, len(ms))
+	copy(sorted, ms)
+	err := SortMoney(sorted)
+
+	return sorted, err
+}
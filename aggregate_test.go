@@ -0,0 +1,91 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	s, err := money.Sum([]money.Money{money.EUR(100), money.EUR(250), money.EUR(-50)})
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(300), s)
+
+	_, err = money.Sum([]money.Money{money.EUR(100), money.USD(250)})
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+
+	_, err = money.Sum(nil)
+	assert.True(t, errors.Is(err, money.ErrEmpty))
+
+	_, err = money.Sum([]money.Money{money.EUR(math.MaxInt64), money.EUR(1)})
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+}
+
+func TestAverage(t *testing.T) {
+	avg, err := money.Average([]money.Money{money.EUR(100), money.EUR(101)}, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(100), avg)
+
+	avg, err = money.Average([]money.Money{money.EUR(100), money.EUR(101)}, money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(101), avg)
+
+	avg, err = money.Average([]money.Money{money.EUR(math.MaxInt64), money.EUR(math.MaxInt64)}, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(math.MaxInt64), avg)
+
+	_, err = money.Average([]money.Money{money.EUR(100), money.JPY(1)}, money.RoundHalfEven)
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
+
+func TestMedian(t *testing.T) {
+	m, err := money.Median([]money.Money{money.EUR(300), money.EUR(100), money.EUR(200)}, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(200), m)
+
+	m, err = money.Median([]money.Money{money.EUR(400), money.EUR(100), money.EUR(201), money.EUR(300)}, money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(251), m)
+
+	_, err = money.Median([]money.Money{}, money.RoundHalfEven)
+	assert.True(t, errors.Is(err, money.ErrEmpty))
+}
+
+func TestPercentile(t *testing.T) {
+	ms := []money.Money{money.EUR(15), money.EUR(50), money.EUR(35), money.EUR(20), money.EUR(40)}
+	tests := []struct {
+		p    int
+		want money.Money
+	}{
+		{0, money.EUR(15)},
+		{5, money.EUR(15)},
+		{30, money.EUR(20)},
+		{40, money.EUR(20)},
+		{50, money.EUR(35)},
+		{100, money.EUR(50)},
+	}
+	for _, tt := range tests {
+		got, err := money.Percentile(ms, tt.p)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got, "percentile %d", tt.p)
+	}
+
+	_, err := money.Percentile(ms, 101)
+	assert.NotNil(t, err)
+
+	// the input is left untouched
+	assert.Equal(t, money.EUR(15), ms[0])
+	assert.Equal(t, money.EUR(50), ms[1])
+}
+
+func TestSortMoney(t *testing.T) {
+	ms := []money.Money{money.EUR(300), money.EUR(-100), money.EUR(200)}
+	assert.Nil(t, money.SortMoney(ms))
+	assert.Equal(t, []money.Money{money.EUR(-100), money.EUR(200), money.EUR(300)}, ms)
+
+	err := money.SortMoney([]money.Money{money.EUR(300), money.GBP(100)})
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Allocate splits the money by the given ratios without losing or creating minor units.
+// Every part gets its truncated share, the leftover minor units are handed out one by one
+// to the parts with a non zero ratio starting from the first, so the parts always sum to m
+func (m Money) Allocate(ratios ...int) (parts []Money, err error) {
+	if len(ratios) == 0 {
+		return parts, errors.New("can't allocate without ratios")
+	}
+
+	// the ratios are summed as big.Int so huge ratios can't overflow the total
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return parts, errors.New("can't allocate with a negative ratio")
+		}
+		total.Add(total, big.NewInt(int64(r)))
+	}
+	if total.Sign() == 0 {
+		return parts, errors.New("can't allocate when all ratios are zero")
+	}
+
+	amount := big.NewInt(m.Amount.Int64())
+	parts = make([]Money, len(ratios))
+	left := m.Amount.Int64()
+	for i, r := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(int64(r)))
+		share.Quo(share, total)
+		parts[i] = ForgeWellFormed(share.Int64(), m.Currency)
+		left -= share.Int64()
+	}
+
+	unit := int64(1)
+	if left < 0 {
+		unit = -1
+	}
+	// the truncated shares lose less than one minor unit each so one pass hands out the whole leftover
+	for i := 0; left != 0 && i < len(ratios); i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i].Amount += Amount(unit)
+		left -= unit
+	}
+
+	return parts, err
+}
+
+func (m Money) MustAllocate(ratios ...int) (parts []Money) {
+	parts, err := m.Allocate(ratios...)
+	if err != nil {
+		panic(err)
+	}
+
+	return parts
+}
+
+// Split splits the money in n parts as equal as possible, the first parts get the leftover minor units
+func (m Money) Split(n int) (parts []Money, err error) {
+	if n <= 0 {
+		return parts, errors.New("can't split in less than one part")
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return m.Allocate(ratios...)
+}
+
+func (m Money) MustSplit(n int) (parts []Money) {
+	parts, err := m.Split(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return parts
+}
@@ -0,0 +1,63 @@
+package money_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Allocate(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount money.Money
+		ratios []int
+		want   []money.Money
+	}{
+		{"even", money.EUR(100), []int{1, 1}, []money.Money{money.EUR(50), money.EUR(50)}},
+		{"leftover to first", money.EUR(5), []int{3, 7}, []money.Money{money.EUR(2), money.EUR(3)}},
+		{"seventy thirty", money.EUR(1001), []int{70, 30}, []money.Money{money.EUR(701), money.EUR(300)}},
+		{"zero ratio gets nothing", money.EUR(101), []int{0, 1, 1}, []money.Money{money.EUR(0), money.EUR(51), money.EUR(50)}},
+		{"negative amount", money.EUR(-101), []int{1, 1}, []money.Money{money.EUR(-51), money.EUR(-50)}},
+		{"zero decimals", money.JPY(10), []int{1, 1, 1}, []money.Money{money.JPY(4), money.JPY(3), money.JPY(3)}},
+		{"big amount", money.EUR(math.MaxInt64), []int{1, 1}, []money.Money{money.EUR(math.MaxInt64/2 + 1), money.EUR(math.MaxInt64 / 2)}},
+		{"huge ratios", money.EUR(100), []int{math.MaxInt64, 1}, []money.Money{money.EUR(100), money.EUR(0)}},
+		{"huge ratios sum over int64", money.EUR(101), []int{math.MaxInt64, math.MaxInt64}, []money.Money{money.EUR(51), money.EUR(50)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.amount.Allocate(tt.ratios...)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+
+			sum := money.ForgeWithCurrency(0, tt.amount.Currency)
+			for _, p := range got {
+				sum = sum.MustAdd(p)
+			}
+			assert.True(t, sum.IsEquals(tt.amount))
+		})
+	}
+}
+
+func TestMoney_AllocateErrors(t *testing.T) {
+	_, err := money.EUR(100).Allocate()
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(100).Allocate(0, 0)
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(100).Allocate(1, -1)
+	assert.NotNil(t, err)
+
+	assert.Panics(t, func() { money.EUR(100).MustAllocate() })
+}
+
+func TestMoney_Split(t *testing.T) {
+	got, err := money.EUR(100).Split(3)
+	assert.Nil(t, err)
+	assert.Equal(t, []money.Money{money.EUR(34), money.EUR(33), money.EUR(33)}, got)
+
+	_, err = money.EUR(100).Split(0)
+	assert.NotNil(t, err)
+}
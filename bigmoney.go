@@ -0,0 +1,327 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// BigMoney is the arbitrary precision sibling of Money, the amount is a big.Int in minor units.
+// Use it for totals that don't fit int64 and convert back with ToMoney when they do.
+// A BigMoney never mutates its Amount, every operation returns a new value.
+type BigMoney struct {
+	Amount   *big.Int `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// ForgeBig
+// amount   *big.Int An integer in cents, it's copied
+// currCode string   Three-letter ISO currency code
+// it fails with *WithdrawnCurrencyError for a withdrawn currency after RefuseWithdrawnCurrencies(true)
+func ForgeBig(amount *big.Int, currCode string) (m BigMoney, err error) {
+	c, err := defaultRegistry.forgeCurrency(currCode)
+	if err != nil {
+		return m, err
+	}
+
+	return ForgeBigWithCurrency(amount, c), err
+}
+
+func MustForgeBig(amount *big.Int, currCode string) BigMoney {
+	m, err := ForgeBig(amount, currCode)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// ForgeBigWithCurrency
+// amount   *big.Int An integer in cents, it's copied
+// currency Currency The currency Value Object, kept like ForgeWellFormed
+func ForgeBigWithCurrency(amount *big.Int, c Currency) BigMoney {
+	m := ForgeWellFormed(0, c)
+	a := new(big.Int)
+	if amount != nil {
+		a.Set(amount)
+	}
+
+	return BigMoney{Amount: a, Currency: m.Currency}
+}
+
+// ToBig returns the same money as BigMoney
+func (m Money) ToBig() BigMoney {
+	return BigMoney{Amount: big.NewInt(m.Amount.Int64()), Currency: ForgeWellFormed(0, m.Currency).Currency}
+}
+
+// ToMoney returns the same money as Money, it fails with ErrOverflow when the amount doesn't fit int64
+func (m BigMoney) ToMoney() (s Money, err error) {
+	a := m.amount()
+	if !a.IsInt64() {
+		return s, ErrOverflow
+	}
+
+	return ForgeWellFormed(a.Int64(), m.Currency), err
+}
+
+func (m BigMoney) MustToMoney() Money {
+	s, err := m.ToMoney()
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// amount never returns nil, the zero value of BigMoney is a zero amount
+func (m BigMoney) amount() *big.Int {
+	if m.Amount == nil {
+		return new(big.Int)
+	}
+
+	return m.Amount
+}
+
+func (m BigMoney) IsZero() bool {
+	return m.amount().Sign() == 0
+}
+
+func (m BigMoney) IsPositive() bool {
+	return m.amount().Sign() > 0
+}
+
+func (m BigMoney) IsNegative() bool {
+	return m.amount().Sign() < 0
+}
+
+func (m BigMoney) IsEquals(cmp BigMoney) bool {
+	return m.amount().Cmp(cmp.amount()) == 0 && m.Currency.IsEquals(cmp.Currency)
+}
+
+// Cmp compares m and cmp, it returns -1 if m < cmp, 0 if m == cmp and +1 if m > cmp
+func (m BigMoney) Cmp(cmp BigMoney) (int, error) {
+	if !m.Currency.IsEquals(cmp.Currency) {
+		return 0, &CurrencyMismatchError{Left: m.Currency, Right: cmp.Currency}
+	}
+
+	return m.amount().Cmp(cmp.amount()), nil
+}
+
+func (m BigMoney) Add(addendum BigMoney) (s BigMoney, err error) {
+	if !m.Currency.IsEquals(addendum.Currency) {
+		return s, &CurrencyMismatchError{Left: m.Currency, Right: addendum.Currency}
+	}
+
+	return BigMoney{Amount: new(big.Int).Add(m.amount(), addendum.amount()), Currency: m.Currency}, err
+}
+
+func (m BigMoney) MustAdd(addendum BigMoney) (s BigMoney) {
+	s, err := m.Add(addendum)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func (m BigMoney) Subtract(subtrahend BigMoney) (s BigMoney, err error) {
+	if !m.Currency.IsEquals(subtrahend.Currency) {
+		return s, &CurrencyMismatchError{Left: m.Currency, Right: subtrahend.Currency}
+	}
+
+	return BigMoney{Amount: new(big.Int).Sub(m.amount(), subtrahend.amount()), Currency: m.Currency}, err
+}
+
+func (m BigMoney) MustSubtract(subtrahend BigMoney) (s BigMoney) {
+	s, err := m.Subtract(subtrahend)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Multiply returns the money multiplied by an integer factor
+func (m BigMoney) Multiply(factor int64) BigMoney {
+	return BigMoney{Amount: new(big.Int).Mul(m.amount(), big.NewInt(factor)), Currency: m.Currency}
+}
+
+// MultiplyRatio returns the money multiplied by num/den, the result is rounded to the minor unit with mode
+func (m BigMoney) MultiplyRatio(num, den int64, mode RoundingMode) (s BigMoney, err error) {
+	if den == 0 {
+		return s, errors.New("can't multiply by a ratio with zero denominator")
+	}
+
+	n := new(big.Int).Mul(m.amount(), big.NewInt(num))
+
+	return BigMoney{Amount: quoRound(n, big.NewInt(den), mode), Currency: m.Currency}, err
+}
+
+// Divide returns the money divided by divisor, the result is rounded to the minor unit with mode
+func (m BigMoney) Divide(divisor int64, mode RoundingMode) (s BigMoney, err error) {
+	if divisor == 0 {
+		return s, errors.New("can't divide by zero")
+	}
+
+	return m.MultiplyRatio(1, divisor, mode)
+}
+
+// AmountAsString returns the exact amount in units like "1234.56"
+func (m BigMoney) AmountAsString() string {
+	return formatMinorUnits(m.amount(), m.Currency.MinorUnit)
+}
+
+func (m BigMoney) String() string {
+	return fmt.Sprintf("%s %s", m.Currency.String(), m.amount().String())
+}
+
+// ParseBigWithFallback Create a big money object by a string like "EUR 123" or "123" using the fallback currency
+func ParseBigWithFallback(s string, fallbackCurr Currency) (m BigMoney, err error) {
+	curr := fallbackCurr
+	if !fallbackCurr.IsValid() {
+		curr, err = CurrencyByISOCode(DefaultCurrencyCode)
+		if err != nil {
+			return m, err
+		}
+	}
+	m = ForgeBigWithCurrency(nil, curr)
+
+	if s == "" {
+		return m, &ParseError{Input: s, Reason: "empty string"}
+	}
+
+	ss := strings.Fields(s)
+	if len(ss) != 1 && len(ss) != 2 {
+		offset := fieldOffset(s, 0)
+		if len(ss) > 2 {
+			offset = fieldOffset(s, 2)
+		}
+		return m, &ParseError{Input: s, Offset: offset, Reason: "money field should be like `EUR 123`"}
+	}
+
+	amount, ok := new(big.Int).SetString(ss[len(ss)-1], 10)
+	if !ok {
+		return m, &ParseError{Input: s, Offset: fieldOffset(s, len(ss)-1), Reason: "invalid amount"}
+	}
+
+	if len(ss) == 2 {
+		curr, err = CurrencyByISOCode(ss[0])
+		if err != nil {
+			return m, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "invalid currency", Err: err}
+		}
+	}
+
+	return BigMoney{Amount: amount, Currency: curr}, err
+}
+
+// ParseBig Create a big money object by a string like "EUR 123" "CurrencyCode Integer" or "Integer"
+func ParseBig(s string) (m BigMoney, err error) {
+	return ParseBigWithFallback(s, Currency{})
+}
+
+// BigDTO is the json shape of BigMoney, the amount is a string to not lose precision
+type BigDTO struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	Symbol   string `json:"symbol"`
+	Cents    int    `json:"cents"`
+}
+
+func (m BigMoney) ExtractDTO() BigDTO {
+	return BigDTO{m.amount().String(),
+		m.Currency.Code,
+		m.Currency.Symbol,
+		m.Currency.GetCents(),
+	}
+}
+
+func (d BigDTO) ExtractMoney() (m BigMoney, err error) {
+	amount, ok := new(big.Int).SetString(d.Amount, 10)
+	if !ok {
+		return m, &ParseError{Input: d.Amount, Reason: "invalid amount"}
+	}
+
+	return ForgeBig(amount, d.Currency)
+}
+
+func (m *BigMoney) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.ExtractDTO())
+}
+
+// UnmarshalJSON accepts the amount both as string and as json number
+func (m *BigMoney) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency string          `json:"currency"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.Currency == "" {
+		return &ParseError{Input: string(data), Reason: "missing currency"}
+	}
+
+	dto := BigDTO{Amount: strings.Trim(string(raw.Amount), `"`), Currency: raw.Currency}
+	bm, err := dto.ExtractMoney()
+	if err != nil {
+		return err
+	}
+	*m = bm
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface, the currency of m is used when the value has none
+func (m *BigMoney) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return m.scanString(v)
+	case []byte:
+		return m.scanString(string(v))
+	case int64:
+		*m = ForgeBigWithCurrency(big.NewInt(v), m.Currency)
+		return nil
+	}
+
+	return fmt.Errorf("can't convert given %v", value)
+}
+
+func (m *BigMoney) scanString(s string) error {
+	bm, err := ParseBigWithFallback(s, m.Currency)
+	if err != nil {
+		return err
+	}
+	*m = bm
+
+	return nil
+}
+
+// Value implements the driver Valuer interface, the amount is stored as decimal string of minor units
+func (m *BigMoney) Value() (driver.Value, error) {
+	return m.amount().String(), nil
+}
+
+// formatMinorUnits formats an integer amount of minor units as a decimal string with minorUnit digits
+func formatMinorUnits(a *big.Int, minorUnit int) string {
+	digits := new(big.Int).Abs(a).String()
+	sign := ""
+	if a.Sign() < 0 {
+		sign = "-"
+	}
+	if minorUnit <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= minorUnit {
+		digits = strings.Repeat("0", minorUnit-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-minorUnit] + "." + digits[len(digits)-minorUnit:]
+}
@@ -0,0 +1,130 @@
+package money_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func bigInt(s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big int " + s)
+	}
+	return i
+}
+
+func TestForgeBig(t *testing.T) {
+	m, err := money.ForgeBig(bigInt("123456789012345678901234567890"), "irr")
+	assert.Nil(t, err)
+	assert.Equal(t, "IRR", m.Currency.Code)
+	assert.Equal(t, "IRR 123456789012345678901234567890", m.String())
+	assert.Equal(t, "1234567890123456789012345678.90", m.AmountAsString())
+
+	_, err = money.ForgeBig(big.NewInt(1), "Monopoly")
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{}))
+
+	// the amount is copied
+	a := big.NewInt(100)
+	m = money.MustForgeBig(a, "EUR")
+	a.SetInt64(200)
+	assert.Equal(t, "EUR 100", m.String())
+}
+
+func TestBigMoney_AmountAsString(t *testing.T) {
+	assert.Equal(t, "0.05", money.EUR(5).ToBig().AmountAsString())
+	assert.Equal(t, "-0.05", money.EUR(-5).ToBig().AmountAsString())
+	assert.Equal(t, "-12", money.JPY(-12).ToBig().AmountAsString())
+	assert.Equal(t, "1.011", money.JOD(1011).ToBig().AmountAsString())
+}
+
+func TestBigMoney_Math(t *testing.T) {
+	max := money.EUR(math.MaxInt64).ToBig()
+
+	s, err := max.Add(max)
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR 18446744073709551614", s.String())
+
+	_, err = s.ToMoney()
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	back, err := s.MustSubtract(max).ToMoney()
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(math.MaxInt64), back)
+
+	_, err = max.Add(money.USD(1).ToBig())
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+
+	c, err := s.Cmp(max)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c)
+
+	assert.Equal(t, "EUR 300", money.EUR(100).ToBig().Multiply(3).String())
+
+	d, err := money.EUR(25).ToBig().Divide(10, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR 2", d.String())
+
+	assert.True(t, money.BigMoney{}.IsZero())
+}
+
+func TestParseBig(t *testing.T) {
+	m, err := money.ParseBig("VES 99999999999999999999999")
+	assert.Nil(t, err)
+	assert.True(t, m.IsEquals(money.MustForgeBig(bigInt("99999999999999999999999"), "VES")))
+
+	m, err = money.ParseBigWithFallback("123", money.MustGetCurrencyByISOCode("USD"))
+	assert.Nil(t, err)
+	assert.True(t, m.IsEquals(money.USD(123).ToBig()))
+
+	_, err = money.ParseBig("EUR 12.3")
+	var parseErr *money.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 4, parseErr.Offset)
+}
+
+func TestBigMoney_JSON(t *testing.T) {
+	m := money.MustForgeBig(bigInt("99999999999999999999999"), "EUR")
+
+	got, err := json.Marshal(&m)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"amount":"99999999999999999999999","currency":"EUR","symbol":"€","cents":100}`, string(got))
+
+	cmp := money.BigMoney{}
+	assert.Nil(t, json.Unmarshal(got, &cmp))
+	assert.True(t, m.IsEquals(cmp))
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"amount":123,"currency":"USD"}`), &cmp))
+	assert.True(t, cmp.IsEquals(money.USD(123).ToBig()))
+
+	err = cmp.UnmarshalJSON([]byte(`{"amount":123,"currency":""}`))
+	var parseErr *money.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "missing currency", parseErr.Reason)
+	assert.Equal(t, `{"amount":123,"currency":""}`, parseErr.Input)
+}
+
+func TestBigMoney_ScanValue(t *testing.T) {
+	m := money.MustForgeBig(bigInt("99999999999999999999999"), "USD")
+
+	v, err := m.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "99999999999999999999999", v)
+
+	scanned := money.BigMoney{Currency: money.MustGetCurrencyByISOCode("USD")}
+	assert.Nil(t, scanned.Scan(v))
+	assert.True(t, m.IsEquals(scanned))
+
+	assert.Nil(t, scanned.Scan([]byte("GBP 12")))
+	assert.True(t, scanned.IsEquals(money.GBP(12).ToBig()))
+
+	assert.Nil(t, scanned.Scan(int64(12)))
+	assert.True(t, scanned.IsEquals(money.GBP(12).ToBig()))
+
+	assert.NotNil(t, scanned.Scan(12.5))
+}
@@ -0,0 +1,33 @@
+package money
+
+import (
+	"math/big"
+)
+
+// RoundToCash rounds the money to the currency cash increment with mode, e.g. CHF 12.23 to CHF 12.25.
+// diff is rounded minus m, it's the amount to post to the rounding account.
+func (m Money) RoundToCash(mode RoundingMode) (rounded Money, diff Money, err error) {
+	inc := big.NewInt(int64(m.Currency.CashIncrement()))
+	q := quoRound(big.NewInt(m.Amount.Int64()), inc, mode)
+	q.Mul(q, inc)
+	if !q.IsInt64() {
+		return rounded, diff, ErrOverflow
+	}
+
+	rounded = ForgeWellFormed(q.Int64(), m.Currency)
+	diff, err = rounded.Subtract(m)
+	if err != nil {
+		return Money{}, Money{}, err
+	}
+
+	return rounded, diff, err
+}
+
+func (m Money) MustRoundToCash(mode RoundingMode) (rounded Money, diff Money) {
+	rounded, diff, err := m.RoundToCash(mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return rounded, diff
+}
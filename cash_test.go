@@ -0,0 +1,49 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_RoundToCash(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        money.Money
+		mode     money.RoundingMode
+		rounded  money.Money
+		wantDiff money.Money
+	}{
+		{"chf up", money.CHF(1223), money.RoundHalfUp, money.CHF(1225), money.CHF(2)},
+		{"chf down", money.CHF(1222), money.RoundHalfUp, money.CHF(1220), money.CHF(-2)},
+		{"chf tie", money.CHF(1225), money.RoundHalfUp, money.CHF(1225), money.CHF(0)},
+		{"chf negative", money.CHF(-1223), money.RoundHalfUp, money.CHF(-1225), money.CHF(-2)},
+		{"cad floor", money.CAD(1099), money.RoundFloor, money.CAD(1095), money.CAD(-4)},
+		{"nzd ten cents down", money.NZD(1234), money.RoundHalfUp, money.NZD(1230), money.NZD(-4)},
+		{"nzd ten cents tie", money.NZD(1235), money.RoundHalfUp, money.NZD(1240), money.NZD(5)},
+		{"eur no cash rounding", money.EUR(1223), money.RoundHalfUp, money.EUR(1223), money.EUR(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rounded, diff, err := tt.m.RoundToCash(tt.mode)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.rounded, rounded)
+			assert.Equal(t, tt.wantDiff, diff)
+			assert.True(t, tt.m.MustAdd(diff).IsEquals(rounded))
+		})
+	}
+}
+
+func TestMoney_RoundToCashOverflow(t *testing.T) {
+	_, _, err := money.CHF(math.MaxInt64).RoundToCash(money.RoundUp)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+}
+
+func TestCurrency_CashIncrement(t *testing.T) {
+	assert.Equal(t, 5, money.MustGetCurrencyByISOCode("CHF").CashIncrement())
+	assert.Equal(t, 10, money.MustGetCurrencyByISOCode("NZD").CashIncrement())
+	assert.Equal(t, 1, money.MustGetCurrencyByISOCode("EUR").CashIncrement())
+}
@@ -0,0 +1,126 @@
+package money
+
+import (
+	"math"
+)
+
+// Cmp compares m and cmp, it returns -1 if m < cmp, 0 if m == cmp and +1 if m > cmp
+func (m Money) Cmp(cmp Money) (int, error) {
+	if !m.Currency.IsEquals(cmp.Currency) {
+		return 0, &CurrencyMismatchError{Left: m.Currency, Right: cmp.Currency}
+	}
+
+	switch {
+	case m.Amount < cmp.Amount:
+		return -1, nil
+	case m.Amount > cmp.Amount:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (m Money) MustCmp(cmp Money) int {
+	c, err := m.Cmp(cmp)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func (m Money) GreaterThan(cmp Money) (bool, error) {
+	c, err := m.Cmp(cmp)
+	return c > 0, err
+}
+
+func (m Money) GreaterThanOrEqual(cmp Money) (bool, error) {
+	c, err := m.Cmp(cmp)
+	return err == nil && c >= 0, err
+}
+
+func (m Money) LessThan(cmp Money) (bool, error) {
+	c, err := m.Cmp(cmp)
+	return c < 0, err
+}
+
+func (m Money) LessThanOrEqual(cmp Money) (bool, error) {
+	c, err := m.Cmp(cmp)
+	return err == nil && c <= 0, err
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Abs returns the absolute value, it fails with ErrOverflow for the smallest amount
+func (m Money) Abs() (s Money, err error) {
+	if m.IsNegative() {
+		return m.Negate()
+	}
+
+	return m, err
+}
+
+func (m Money) MustAbs() (s Money) {
+	s, err := m.Abs()
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Negate returns the money with the opposite sign, it fails with ErrOverflow for the smallest amount
+func (m Money) Negate() (s Money, err error) {
+	if m.Amount == math.MinInt64 {
+		return s, ErrOverflow
+	}
+
+	return ForgeWellFormed(-m.Amount.Int64(), m.Currency), err
+}
+
+func (m Money) MustNegate() (s Money) {
+	s, err := m.Negate()
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Min returns the smallest money, all of them must have the same currency
+func Min(first Money, others ...Money) (min Money, err error) {
+	min = first
+	for _, o := range others {
+		c, err := o.Cmp(min)
+		if err != nil {
+			return Money{}, err
+		}
+		if c < 0 {
+			min = o
+		}
+	}
+
+	return min, err
+}
+
+// Max returns the biggest money, all of them must have the same currency
+func Max(first Money, others ...Money) (max Money, err error) {
+	max = first
+	for _, o := range others {
+		c, err := o.Cmp(max)
+		if err != nil {
+			return Money{}, err
+		}
+		if c > 0 {
+			max = o
+		}
+	}
+
+	return max, err
+}
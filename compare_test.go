@@ -0,0 +1,81 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Cmp(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b money.Money
+		want int
+	}{
+		{"less", money.EUR(99), money.EUR(100), -1},
+		{"equal", money.EUR(100), money.EUR(100), 0},
+		{"greater", money.EUR(101), money.EUR(100), 1},
+		{"negative", money.EUR(-101), money.EUR(-100), -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Cmp(tt.b)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+
+			gt, _ := tt.a.GreaterThan(tt.b)
+			gte, _ := tt.a.GreaterThanOrEqual(tt.b)
+			lt, _ := tt.a.LessThan(tt.b)
+			lte, _ := tt.a.LessThanOrEqual(tt.b)
+			assert.Equal(t, tt.want > 0, gt)
+			assert.Equal(t, tt.want >= 0, gte)
+			assert.Equal(t, tt.want < 0, lt)
+			assert.Equal(t, tt.want <= 0, lte)
+		})
+	}
+}
+
+func TestMoney_CmpDifferentCurrency(t *testing.T) {
+	_, err := money.EUR(100).Cmp(money.USD(100))
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+
+	gte, err := money.EUR(100).GreaterThanOrEqual(money.USD(100))
+	assert.False(t, gte)
+	assert.NotNil(t, err)
+
+	lte, err := money.EUR(100).LessThanOrEqual(money.USD(100))
+	assert.False(t, lte)
+	assert.NotNil(t, err)
+
+	assert.Panics(t, func() { money.EUR(100).MustCmp(money.USD(100)) })
+}
+
+func TestMoney_Sign(t *testing.T) {
+	assert.True(t, money.EUR(1).IsPositive())
+	assert.False(t, money.EUR(0).IsPositive())
+	assert.True(t, money.EUR(-1).IsNegative())
+	assert.False(t, money.EUR(0).IsNegative())
+
+	assert.Equal(t, money.EUR(100), money.EUR(-100).MustAbs())
+	assert.Equal(t, money.EUR(100), money.EUR(100).MustAbs())
+	assert.Equal(t, money.EUR(-100), money.EUR(100).MustNegate())
+
+	_, err := money.EUR(math.MinInt64).Abs()
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+}
+
+func TestMinMax(t *testing.T) {
+	min, err := money.Min(money.EUR(300), money.EUR(100), money.EUR(200))
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(100), min)
+
+	max, err := money.Max(money.EUR(300), money.EUR(100), money.EUR(400))
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(400), max)
+
+	_, err = money.Max(money.EUR(300), money.USD(100))
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
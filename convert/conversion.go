@@ -1,9 +1,7 @@
 package convert
 
 import (
-	"fmt"
 	"github.com/radical-app/money"
-	"math"
 )
 
 type Rate struct {
@@ -32,31 +30,66 @@ func ConvertTo(obj *money.Money, rate Rate) (res *money.Money, err error) {
 		return convertToSource(obj, rate)
 	}
 
-	return nil, fmt.Errorf("money currency and rate doesn't match: currency %s, rate source %s, rate target %s", obj.Currency.String(), rate.Source.String(), rate.Target.String())
+	return nil, &RateMismatchError{Currency: obj.Currency, Rate: rate}
 }
 
-
 func convertFromSource(obj *money.Money, rate Rate) (res *money.Money, err error) {
 	amountFrom := obj.Float()
 	toRate := rate.Rate
-	centsCount := math.Pow(10, float64(rate.Target.MinorUnit))
-	resultAmountInCents := int64(math.Round(amountFrom * toRate * centsCount))
-	result, err := money.Forge(resultAmountInCents, rate.Target.Code)
+	// ForgeFloat refuses a withdrawn target when asked, see money.RefuseWithdrawnCurrencies
+	result, err := money.ForgeFloat(amountFrom*toRate, rate.Target.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &result, err
 }
 
-
 func convertToSource(obj *money.Money, rate Rate) (res *money.Money, err error) {
 	amountFrom := obj.Float()
 	toRate := rate.Rate
-	centsCount := math.Pow(10, float64(rate.Source.MinorUnit))
-	resultAmountInCents := int64(math.Round(amountFrom / toRate * centsCount))
-	result, err := money.Forge(resultAmountInCents, rate.Source.Code)
+	result, err := money.ForgeFloat(amountFrom/toRate, rate.Source.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &result, err
-}
\ No newline at end of file
+}
+
+// Rates is a list of rates, it converts money in both directions of every rate
+type Rates []Rate
+
+// Convert converts m to target with the first rate that has both currencies
+func (rs Rates) Convert(m money.Money, target money.Currency) (money.Money, error) {
+	if m.Currency.IsEquals(target) {
+		return m, nil
+	}
+
+	for _, r := range rs {
+		if (r.Source.IsEquals(m.Currency) && r.Target.IsEquals(target)) ||
+			(r.Target.IsEquals(m.Currency) && r.Source.IsEquals(target)) {
+			res, err := ConvertTo(&m, r)
+			if err != nil {
+				return money.Money{}, err
+			}
+			return *res, nil
+		}
+	}
+
+	return money.Money{}, &MissingRateError{From: m.Currency, To: target}
+}
+
+// ForgeMoneySet converts m to the target currency with rate and keeps both amounts and the rate as given
+func ForgeMoneySet(m money.Money, target money.Currency, rate Rate) (s money.MoneySet, err error) {
+	if !(rate.Source.IsEquals(m.Currency) && rate.Target.IsEquals(target)) &&
+		!(rate.Target.IsEquals(m.Currency) && rate.Source.IsEquals(target)) {
+		return s, &RateMismatchError{Currency: m.Currency, Rate: rate}
+	}
+
+	presenter, err := ConvertTo(&m, rate)
+	if err != nil {
+		return s, err
+	}
+
+	used := money.ExchangeRate{Source: rate.Source, Target: rate.Target, Rate: rate.Rate}
+
+	return money.MoneySet{Money: m, MoneyPresenter: *presenter, Rate: used}, err
+}
@@ -1,6 +1,9 @@
 package convert
 
 import (
+	"errors"
+	"math"
+
 	"github.com/radical-app/money"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -103,3 +106,82 @@ func TestConvertTo(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertTo_overflow(t *testing.T) {
+	obj := money.MustForge(math.MaxInt64/2, "EUR")
+	rate := ForgeRate(money.MustGetCurrencyByISOCode("EUR"), money.MustGetCurrencyByISOCode("IRR"), 50000)
+
+	_, err := ConvertTo(&obj, rate)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+}
+
+func TestConvertTo_rateMismatch(t *testing.T) {
+	obj := money.MustForge(100, "GBP")
+	rate := ForgeRate(money.MustGetCurrencyByISOCode("USD"), money.MustGetCurrencyByISOCode("EUR"), 2)
+
+	_, err := ConvertTo(&obj, rate)
+
+	var mismatch *RateMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "GBP", mismatch.Currency.Code)
+	assert.Equal(t, rate, mismatch.Rate)
+}
+
+func TestRates_Convert(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+	usd := money.MustGetCurrencyByISOCode("USD")
+	rates := Rates{ForgeRate(eur, usd, 1.1)}
+
+	got, err := rates.Convert(money.EUR(100), usd)
+	assert.Nil(t, err)
+	assert.Equal(t, money.USD(110), got)
+
+	got, err = rates.Convert(money.USD(110), eur)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(100), got)
+
+	got, err = rates.Convert(money.EUR(100), eur)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(100), got)
+
+	_, err = rates.Convert(money.GBP(100), eur)
+	var missing *MissingRateError
+	assert.True(t, errors.As(err, &missing))
+	assert.Equal(t, "GBP", missing.From.Code)
+}
+
+func TestForgeMoneySet(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+	usd := money.MustGetCurrencyByISOCode("USD")
+	gbp := money.MustGetCurrencyByISOCode("GBP")
+
+	s, err := ForgeMoneySet(money.USD(1200), eur, ForgeRate(eur, usd, 1.25))
+	assert.Nil(t, err)
+	assert.Equal(t, money.USD(1200), s.Money)
+	assert.Equal(t, money.EUR(960), s.MoneyPresenter)
+	assert.Equal(t, money.ExchangeRate{Source: eur, Target: usd, Rate: 1.25}, s.Rate, "the rate is kept as given")
+	assert.Equal(t, "USD 12.00 (≈ EUR 9.60)", s.String())
+
+	s, err = ForgeMoneySet(money.EUR(960), usd, ForgeRate(eur, usd, 1.25))
+	assert.Nil(t, err)
+	assert.Equal(t, money.USD(1200), s.MoneyPresenter)
+	assert.Equal(t, money.ExchangeRate{Source: eur, Target: usd, Rate: 1.25}, s.Rate)
+
+	_, err = ForgeMoneySet(money.EUR(960), gbp, ForgeRate(eur, usd, 1.25))
+	var mismatch *RateMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+}
+
+func TestConvertTo_refusesWithdrawnCurrency(t *testing.T) {
+	money.RefuseWithdrawnCurrencies(true)
+	defer money.RefuseWithdrawnCurrencies(false)
+
+	eur := money.MustGetCurrencyByISOCode("EUR")
+	hrk := money.MustGetCurrencyByISOCode("HRK")
+	m := money.EUR(100)
+
+	_, err := ConvertTo(&m, ForgeRate(eur, hrk, 7.5345))
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+	_, err = ConvertTo(&m, ForgeRate(hrk, eur, 1/7.5345))
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+}
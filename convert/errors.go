@@ -0,0 +1,27 @@
+package convert
+
+import (
+	"fmt"
+
+	"github.com/radical-app/money"
+)
+
+// RateMismatchError is returned when the money currency is neither the source nor the target of the rate
+type RateMismatchError struct {
+	Currency money.Currency
+	Rate     Rate
+}
+
+func (e *RateMismatchError) Error() string {
+	return fmt.Sprintf("money currency and rate doesn't match: currency %s, rate source %s, rate target %s", e.Currency, e.Rate.Source, e.Rate.Target)
+}
+
+// MissingRateError is returned when no rate converts between two currencies
+type MissingRateError struct {
+	From money.Currency
+	To   money.Currency
+}
+
+func (e *MissingRateError) Error() string {
+	return fmt.Sprintf("no rate to convert %s to %s", e.From, e.To)
+}
@@ -0,0 +1,38 @@
+package money
+
+// MaxMinorUnit is the largest minor unit a currency can have, 10^19 minor units don't fit int64
+const MaxMinorUnit = 18
+
+// cryptoCurrencies is the opt-in catalog of crypto assets, the minor unit is the smallest on-chain unit
+// (satoshi for BTC, wei for ETH) so 1 ETH is 10^18 minor units and at most ~9.22 ETH fit a Money,
+// use BigMoney for larger ETH amounts
+var cryptoCurrencies = []Currency{
+	{Code: "BTC", MinorUnit: 8, Symbol: "₿"},
+	{Code: "ETH", MinorUnit: 18, Symbol: "Ξ"},
+	{Code: "USDC", MinorUnit: 6, Symbol: "USDC"},
+	{Code: "USDT", MinorUnit: 6, Symbol: "USDT"},
+}
+
+// CryptoCurrencies returns the crypto assets catalog, they aren't known until registered,
+// e.g. with RegisterCryptoCurrencies or NewRegistry(money.CryptoCurrencies()...)
+func CryptoCurrencies() []Currency {
+	cs := make([]Currency, len(cryptoCurrencies))
+	copy(cs, cryptoCurrencies)
+
+	return cs
+}
+
+// RegisterCryptoCurrencies adds the crypto assets catalog to the default registry,
+// the ones already registered are skipped
+func RegisterCryptoCurrencies() error {
+	for _, c := range cryptoCurrencies {
+		if _, err := CurrencyByISOCode(c.Code); err == nil {
+			continue
+		}
+		if err := RegisterCurrency(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
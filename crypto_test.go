@@ -0,0 +1,61 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/radical-app/money/moneyfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCryptoCurrencies(t *testing.T) {
+	_, err := money.Forge(1, "BTC")
+	assert.NotNil(t, err, "the catalog is opt-in")
+
+	r := money.MustNewRegistry(money.CryptoCurrencies()...)
+	assert.Equal(t, []string{"BTC", "ETH", "USDC", "USDT"}, r.Codes())
+	assert.Equal(t, 8, r.MustCurrencyByCode("BTC").MinorUnit)
+	assert.Equal(t, 18, r.MustCurrencyByCode("ETH").MinorUnit)
+	assert.Equal(t, 6, r.MustCurrencyByCode("USDC").MinorUnit)
+	assert.Equal(t, 6, r.MustCurrencyByCode("USDT").MinorUnit)
+}
+
+func TestRegisterCryptoCurrencies(t *testing.T) {
+	assert.Nil(t, money.RegisterCryptoCurrencies())
+	assert.Nil(t, money.RegisterCryptoCurrencies(), "already registered are skipped")
+	defer func() {
+		for _, c := range money.CryptoCurrencies() {
+			_ = money.UnregisterCurrency(c.Code)
+		}
+	}()
+
+	btc, err := money.Parse("BTC 123456789")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.23456789", btc.AmountAsString())
+	assert.Equal(t, "1.23456789", moneyfmt.MustDisplayAmount(btc, "en"))
+	assert.Equal(t, "1,23456789", moneyfmt.MustDisplayAmount(btc, "it"))
+
+	eth := money.MustForge(1234567890123456789, "ETH")
+	assert.Equal(t, "1.234567890123456789", eth.AmountAsString())
+	assert.Equal(t, "Ξ 1.234567890123456789", moneyfmt.MustDisplay(eth, "en"))
+
+	_, err = money.Parse("ETH 10000000000000000000")
+	assert.True(t, errors.Is(err, strconv.ErrRange))
+	_, err = money.ForgeFloat(10, "ETH")
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	_, err = money.MustForge(math.MaxInt64, "ETH").Add(money.MustForge(1, "ETH"))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	usdc, err := money.ForgeFloat(1.5, "USDC")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1500000), usdc.Int64())
+}
+
+func TestRegisterCurrency_minorUnitTooLarge(t *testing.T) {
+	err := money.RegisterCurrency(money.Currency{Code: "BIG", MinorUnit: 19})
+	assert.NotNil(t, err)
+	assert.False(t, money.Currency{Code: "BIG", MinorUnit: 19}.IsValid())
+}
@@ -4,18 +4,30 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 )
 
 var DefaultCurrencyCode = "EUR"
 
-// GetCurrencyByCode gets the currency object by currency ISO code
+// GetCurrencyByCode gets the currency object by currency ISO code from the default registry,
+// the currencies added with RegisterCurrency are found too, numeric codes like "978" are accepted
 func CurrencyByISOCode(code string) (currency Currency, err error) {
-	code = strings.ToUpper(code)
-	if c, ok := currencies[code]; ok {
-		return c, nil
+	return defaultRegistry.CurrencyByCode(code)
+}
+
+// CurrencyByNumericCode gets the currency object by ISO 4217 numeric code from the default registry,
+// code is a string like "978" or an int like 978
+func CurrencyByNumericCode(code interface{}) (currency Currency, err error) {
+	return defaultRegistry.CurrencyByNumericCode(code)
+}
+
+func MustCurrencyByNumericCode(code interface{}) Currency {
+	c, err := CurrencyByNumericCode(code)
+	if err != nil {
+		panic(err)
 	}
 
-	return currency, fmt.Errorf("currency not found: code %s", code)
+	return c
 }
 
 // GetCurrencyByCode gets the currency object by currency ISO code
@@ -31,23 +43,53 @@ func MustGetCurrencyByISOCode(code string) (currency Currency) {
 // Currency codes
 // https://www.iso.org/iso-4217-currency-codes.html
 type Currency struct {
-	Code                 string `json:"currency"`
+	Code string `json:"currency"`
+	// NumericCode is the ISO 4217 three digits code like "978" for EUR, empty for the codes outside ISO
+	NumericCode          string `json:"numericCode,omitempty"`
 	MinorUnit            int    `json:"unit"`
 	Symbol               string `json:"symbol"`
 	ShowCodeNextToSymbol bool
+	// CashRounding is the smallest step in minor units used when paying cash, e.g. 5 for CHF 0.05
+	// zero means cash is paid in minor units
+	CashRounding int `json:"cashRounding,omitempty"`
+	// ValidFrom is the first day the currency is legal tender, zero when it's older than the catalog
+	ValidFrom time.Time `json:"-"`
+	// ValidTo is the first day the currency isn't legal tender anymore, zero while it's still in use
+	ValidTo time.Time `json:"-"`
 }
 
 func (c Currency) IsZeroDigitsAfterDecimalSeparator() bool {
 	return c.MinorUnit == 0
 }
 
+// IsValid tells whether the currency code is in the default registry
 func (c Currency) IsValid() bool {
-	c, err := CurrencyByISOCode(c.Code)
-	if err != nil {
+	return c.IsValidIn(defaultRegistry)
+}
+
+// IsValidIn tells whether the currency code is in the registry r
+func (c Currency) IsValidIn(r *Registry) bool {
+	if c.Code == "" || isDigits(c.Code) {
 		return false
 	}
+	_, err := r.CurrencyByCode(c.Code)
 
-	return c.Code != ""
+	return err == nil
+}
+
+// wellFormed checks the shape of a currency without looking it up, it's what a registry needs to register it
+func (c Currency) wellFormed() error {
+	if strings.TrimSpace(c.Code) == "" || strings.ContainsAny(c.Code, " \t\n,") || isDigits(c.Code) {
+		return fmt.Errorf("invalid currency code %q", c.Code)
+	}
+	if c.NumericCode != "" && !isNumericCode(c.NumericCode) {
+		return fmt.Errorf("invalid currency numeric code %q, it must have 3 digits", c.NumericCode)
+	}
+	if c.MinorUnit < 0 || c.MinorUnit > MaxMinorUnit {
+		return fmt.Errorf("currency %s minor unit must be between 0 and %d", c.Code, MaxMinorUnit)
+	}
+
+	return nil
 }
 
 func (c Currency) GetCents() int {
@@ -58,6 +100,20 @@ func (c Currency) GetCents() int {
 	return int(ce)
 }
 
+// CashIncrement returns the smallest step in minor units used when paying cash
+func (c Currency) CashIncrement() int {
+	if c.CashRounding <= 0 {
+		return 1
+	}
+
+	return c.CashRounding
+}
+
+// IsActiveAt tells whether the currency is legal tender at t
+func (c Currency) IsActiveAt(t time.Time) bool {
+	return (c.ValidFrom.IsZero() || !t.Before(c.ValidFrom)) && (c.ValidTo.IsZero() || t.Before(c.ValidTo))
+}
+
 func (c Currency) String() string {
 	return c.Code
 }
@@ -66,167 +122,187 @@ func (c Currency) IsEquals(cmp Currency) bool {
 	return c.Code == cmp.Code && c.MinorUnit == cmp.MinorUnit
 }
 
+// currencies is the ISO 4217 catalog every ISO registry starts from, it's never modified
 var currencies = map[string]Currency{
-	"AED": {Code: "AED", MinorUnit: 2, Symbol: "\u062f\u002e\u0625", ShowCodeNextToSymbol: true},
-	"AFN": {Code: "AFN", MinorUnit: 2, Symbol: "\u060b", ShowCodeNextToSymbol: false},
-	"ALL": {Code: "ALL", MinorUnit: 2, Symbol: "Lek", ShowCodeNextToSymbol: false},
-	"AMD": {Code: "AMD", MinorUnit: 2, Symbol: "\u0564\u0580.", ShowCodeNextToSymbol: false},
-	"ANG": {Code: "ANG", MinorUnit: 2, Symbol: "\u0192", ShowCodeNextToSymbol: true},
-	"AOA": {Code: "AOA", MinorUnit: 2, Symbol: "Kz", ShowCodeNextToSymbol: false},
-	"ARS": {Code: "ARS", MinorUnit: 2, Symbol: "$", ShowCodeNextToSymbol: true},
-	"AUD": {Code: "AUD", MinorUnit: 2, Symbol: "A$", ShowCodeNextToSymbol: false},
-	"AWG": {Code: "AWG", MinorUnit: 2, Symbol: "\u0192", ShowCodeNextToSymbol: true},
-	"AZN": {Code: "AZN", MinorUnit: 2, Symbol: "\u20bc", ShowCodeNextToSymbol: false},
-	"BAM": {Code: "BAM", MinorUnit: 2, Symbol: "KM", ShowCodeNextToSymbol: false},
-	"BBD": {Code: "BBD", MinorUnit: 2, Symbol: "Bds$", ShowCodeNextToSymbol: false},
-	"BDT": {Code: "BDT", MinorUnit: 2, Symbol: "\u09f3", ShowCodeNextToSymbol: false},
-	"BGN": {Code: "BGN", MinorUnit: 2, Symbol: "\u043b\u0432", ShowCodeNextToSymbol: false},
-	"BHD": {Code: "BHD", MinorUnit: 3, Symbol: "\u002e\u062f\u002e\u0628", ShowCodeNextToSymbol: false},
-	"BIF": {Code: "BIF", MinorUnit: 0, Symbol: "FBu", ShowCodeNextToSymbol: false},
-	"BMD": {Code: "BMD", MinorUnit: 2, Symbol: "BD$", ShowCodeNextToSymbol: false},
-	"BND": {Code: "BND", MinorUnit: 2, Symbol: "BND", ShowCodeNextToSymbol: false},
-	"BOB": {Code: "BOB", MinorUnit: 2, Symbol: "Bs.", ShowCodeNextToSymbol: false},
-	"BRL": {Code: "BRL", MinorUnit: 2, Symbol: "R$", ShowCodeNextToSymbol: false},
-	"BSD": {Code: "BSD", MinorUnit: 2, Symbol: "BSD", ShowCodeNextToSymbol: false},
-	"BTN": {Code: "BTN", MinorUnit: 2, Symbol: "Nu.", ShowCodeNextToSymbol: false},
-	"BWP": {Code: "BWP", MinorUnit: 2, Symbol: "P", ShowCodeNextToSymbol: true},
-	"BYN": {Code: "BYN", MinorUnit: 2, Symbol: "Br", ShowCodeNextToSymbol: false},
-	"BZD": {Code: "BZD", MinorUnit: 2, Symbol: "BZ$", ShowCodeNextToSymbol: false},
-	"CAD": {Code: "CAD", MinorUnit: 2, Symbol: "CAD$", ShowCodeNextToSymbol: false},
-	"CDF": {Code: "CDF", MinorUnit: 2, Symbol: "FC", ShowCodeNextToSymbol: false},
-	"CHF": {Code: "CHF", MinorUnit: 2, Symbol: "CHF", ShowCodeNextToSymbol: false},
-	"CLF": {Code: "CLF", MinorUnit: 5, Symbol: "UF", ShowCodeNextToSymbol: false},
-	"CLP": {Code: "CLP", MinorUnit: 0, Symbol: "CLP$", ShowCodeNextToSymbol: false},
-	"CNY": {Code: "CNY", MinorUnit: 2, Symbol: "\u5143", ShowCodeNextToSymbol: false},
-	"COP": {Code: "COP", MinorUnit: 2, Symbol: "COP$", ShowCodeNextToSymbol: false},
-	"CRC": {Code: "CRC", MinorUnit: 2, Symbol: "\u20a1", ShowCodeNextToSymbol: true},
-	"CUC": {Code: "CUC", MinorUnit: 2, Symbol: "CUC$", ShowCodeNextToSymbol: false},
-	"CUP": {Code: "CUP", MinorUnit: 2, Symbol: "$MN", ShowCodeNextToSymbol: false},
-	"CVE": {Code: "CVE", MinorUnit: 2, Symbol: "Esc", ShowCodeNextToSymbol: false},
-	"CZK": {Code: "CZK", MinorUnit: 2, Symbol: "K\u010d", ShowCodeNextToSymbol: false},
-	"DJF": {Code: "DJF", MinorUnit: 0, Symbol: "Fdj", ShowCodeNextToSymbol: false},
-	"DKK": {Code: "DKK", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
-	"DOP": {Code: "DOP", MinorUnit: 2, Symbol: "RD$", ShowCodeNextToSymbol: false},
-	"DZD": {Code: "DZD", MinorUnit: 2, Symbol: ".\u062f.\u062c", ShowCodeNextToSymbol: false},
-	"EGP": {Code: "EGP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"ERN": {Code: "ERN", MinorUnit: 2, Symbol: "Nfk", ShowCodeNextToSymbol: false},
-	"ETB": {Code: "ETB", MinorUnit: 2, Symbol: "Br", ShowCodeNextToSymbol: false},
-	"EUR": {Code: "EUR", MinorUnit: 2, Symbol: "\u20ac", ShowCodeNextToSymbol: false},
-	"FJD": {Code: "FJD", MinorUnit: 2, Symbol: "FJ$", ShowCodeNextToSymbol: false},
-	"FKP": {Code: "FKP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"GBP": {Code: "GBP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: false},
-	"GEL": {Code: "GEL", MinorUnit: 2, Symbol: "\u10da", ShowCodeNextToSymbol: false},
+	"AED": {Code: "AED", NumericCode: "784", MinorUnit: 2, Symbol: "\u062f\u002e\u0625", ShowCodeNextToSymbol: true},
+	"AFN": {Code: "AFN", NumericCode: "971", MinorUnit: 2, Symbol: "\u060b", ShowCodeNextToSymbol: false},
+	"ALL": {Code: "ALL", NumericCode: "008", MinorUnit: 2, Symbol: "Lek", ShowCodeNextToSymbol: false},
+	"AMD": {Code: "AMD", NumericCode: "051", MinorUnit: 2, Symbol: "\u0564\u0580.", ShowCodeNextToSymbol: false},
+	"ANG": {Code: "ANG", NumericCode: "532", MinorUnit: 2, Symbol: "\u0192", ShowCodeNextToSymbol: true},
+	"AOA": {Code: "AOA", NumericCode: "973", MinorUnit: 2, Symbol: "Kz", ShowCodeNextToSymbol: false},
+	"ARS": {Code: "ARS", NumericCode: "032", MinorUnit: 2, Symbol: "$", ShowCodeNextToSymbol: true},
+	"AUD": {Code: "AUD", NumericCode: "036", MinorUnit: 2, Symbol: "A$", ShowCodeNextToSymbol: false, CashRounding: 5},
+	"AWG": {Code: "AWG", NumericCode: "533", MinorUnit: 2, Symbol: "\u0192", ShowCodeNextToSymbol: true},
+	"AZN": {Code: "AZN", NumericCode: "944", MinorUnit: 2, Symbol: "\u20bc", ShowCodeNextToSymbol: false},
+	"BAM": {Code: "BAM", NumericCode: "977", MinorUnit: 2, Symbol: "KM", ShowCodeNextToSymbol: false},
+	"BBD": {Code: "BBD", NumericCode: "052", MinorUnit: 2, Symbol: "Bds$", ShowCodeNextToSymbol: false},
+	"BDT": {Code: "BDT", NumericCode: "050", MinorUnit: 2, Symbol: "\u09f3", ShowCodeNextToSymbol: false},
+	"BGN": {Code: "BGN", NumericCode: "975", MinorUnit: 2, Symbol: "\u043b\u0432", ShowCodeNextToSymbol: false},
+	"BHD": {Code: "BHD", NumericCode: "048", MinorUnit: 3, Symbol: "\u002e\u062f\u002e\u0628", ShowCodeNextToSymbol: false},
+	"BIF": {Code: "BIF", NumericCode: "108", MinorUnit: 0, Symbol: "FBu", ShowCodeNextToSymbol: false},
+	"BMD": {Code: "BMD", NumericCode: "060", MinorUnit: 2, Symbol: "BD$", ShowCodeNextToSymbol: false},
+	"BND": {Code: "BND", NumericCode: "096", MinorUnit: 2, Symbol: "BND", ShowCodeNextToSymbol: false},
+	"BOB": {Code: "BOB", NumericCode: "068", MinorUnit: 2, Symbol: "Bs.", ShowCodeNextToSymbol: false},
+	"BOV": {Code: "BOV", NumericCode: "984", MinorUnit: 2, Symbol: "BOV", ShowCodeNextToSymbol: false},
+	"BRL": {Code: "BRL", NumericCode: "986", MinorUnit: 2, Symbol: "R$", ShowCodeNextToSymbol: false},
+	"BSD": {Code: "BSD", NumericCode: "044", MinorUnit: 2, Symbol: "BSD", ShowCodeNextToSymbol: false},
+	"BTN": {Code: "BTN", NumericCode: "064", MinorUnit: 2, Symbol: "Nu.", ShowCodeNextToSymbol: false},
+	"BWP": {Code: "BWP", NumericCode: "072", MinorUnit: 2, Symbol: "P", ShowCodeNextToSymbol: true},
+	"BYN": {Code: "BYN", NumericCode: "933", MinorUnit: 2, Symbol: "Br", ShowCodeNextToSymbol: false},
+	"BZD": {Code: "BZD", NumericCode: "084", MinorUnit: 2, Symbol: "BZ$", ShowCodeNextToSymbol: false},
+	"CAD": {Code: "CAD", NumericCode: "124", MinorUnit: 2, Symbol: "CAD$", ShowCodeNextToSymbol: false, CashRounding: 5},
+	"CDF": {Code: "CDF", NumericCode: "976", MinorUnit: 2, Symbol: "FC", ShowCodeNextToSymbol: false},
+	"CHE": {Code: "CHE", NumericCode: "947", MinorUnit: 2, Symbol: "CHE", ShowCodeNextToSymbol: false},
+	"CHF": {Code: "CHF", NumericCode: "756", MinorUnit: 2, Symbol: "CHF", ShowCodeNextToSymbol: false, CashRounding: 5},
+	"CHW": {Code: "CHW", NumericCode: "948", MinorUnit: 2, Symbol: "CHW", ShowCodeNextToSymbol: false},
+	"CLF": {Code: "CLF", NumericCode: "990", MinorUnit: 5, Symbol: "UF", ShowCodeNextToSymbol: false},
+	"CLP": {Code: "CLP", NumericCode: "152", MinorUnit: 0, Symbol: "CLP$", ShowCodeNextToSymbol: false},
+	"CNY": {Code: "CNY", NumericCode: "156", MinorUnit: 2, Symbol: "\u5143", ShowCodeNextToSymbol: false},
+	"COP": {Code: "COP", NumericCode: "170", MinorUnit: 2, Symbol: "COP$", ShowCodeNextToSymbol: false},
+	"COU": {Code: "COU", NumericCode: "970", MinorUnit: 2, Symbol: "COU", ShowCodeNextToSymbol: false},
+	"CRC": {Code: "CRC", NumericCode: "188", MinorUnit: 2, Symbol: "\u20a1", ShowCodeNextToSymbol: true},
+	"CUC": {Code: "CUC", NumericCode: "931", MinorUnit: 2, Symbol: "CUC$", ShowCodeNextToSymbol: false},
+	"CUP": {Code: "CUP", NumericCode: "192", MinorUnit: 2, Symbol: "$MN", ShowCodeNextToSymbol: false},
+	"CVE": {Code: "CVE", NumericCode: "132", MinorUnit: 2, Symbol: "Esc", ShowCodeNextToSymbol: false},
+	"CZK": {Code: "CZK", NumericCode: "203", MinorUnit: 2, Symbol: "K\u010d", ShowCodeNextToSymbol: false},
+	"DJF": {Code: "DJF", NumericCode: "262", MinorUnit: 0, Symbol: "Fdj", ShowCodeNextToSymbol: false},
+	"DKK": {Code: "DKK", NumericCode: "208", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
+	"DOP": {Code: "DOP", NumericCode: "214", MinorUnit: 2, Symbol: "RD$", ShowCodeNextToSymbol: false},
+	"DZD": {Code: "DZD", NumericCode: "012", MinorUnit: 2, Symbol: ".\u062f.\u062c", ShowCodeNextToSymbol: false},
+	"EGP": {Code: "EGP", NumericCode: "818", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"ERN": {Code: "ERN", NumericCode: "232", MinorUnit: 2, Symbol: "Nfk", ShowCodeNextToSymbol: false},
+	"ETB": {Code: "ETB", NumericCode: "230", MinorUnit: 2, Symbol: "Br", ShowCodeNextToSymbol: false},
+	"EUR": {Code: "EUR", NumericCode: "978", MinorUnit: 2, Symbol: "\u20ac", ShowCodeNextToSymbol: false},
+	"FJD": {Code: "FJD", NumericCode: "242", MinorUnit: 2, Symbol: "FJ$", ShowCodeNextToSymbol: false},
+	"FKP": {Code: "FKP", NumericCode: "238", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"GBP": {Code: "GBP", NumericCode: "826", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: false},
+	"GEL": {Code: "GEL", NumericCode: "981", MinorUnit: 2, Symbol: "\u10da", ShowCodeNextToSymbol: false},
 	"GGP": {Code: "GGP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: false},
-	"GHS": {Code: "GHS", MinorUnit: 2, Symbol: "\u20b5", ShowCodeNextToSymbol: false},
-	"GIP": {Code: "GIP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"GMD": {Code: "GMD", MinorUnit: 2, Symbol: "D", ShowCodeNextToSymbol: false},
-	"GNF": {Code: "GNF", MinorUnit: 0, Symbol: "FG", ShowCodeNextToSymbol: false},
-	"GTQ": {Code: "GTQ", MinorUnit: 2, Symbol: "Q", ShowCodeNextToSymbol: false},
-	"GYD": {Code: "GYD", MinorUnit: 2, Symbol: "G$", ShowCodeNextToSymbol: false},
-	"HKD": {Code: "HKD", MinorUnit: 2, Symbol: "HK$", ShowCodeNextToSymbol: false},
-	"HNL": {Code: "HNL", MinorUnit: 2, Symbol: "L", ShowCodeNextToSymbol: true},
-	"HRK": {Code: "HRK", MinorUnit: 2, Symbol: "kn", ShowCodeNextToSymbol: false},
-	"HTG": {Code: "HTG", MinorUnit: 2, Symbol: "G", ShowCodeNextToSymbol: false},
-	"HUF": {Code: "HUF", MinorUnit: 0, Symbol: "Ft", ShowCodeNextToSymbol: false},
-	"IDR": {Code: "IDR", MinorUnit: 2, Symbol: "Rp", ShowCodeNextToSymbol: false},
-	"ILS": {Code: "ILS", MinorUnit: 2, Symbol: "\u20aa", ShowCodeNextToSymbol: false},
+	"GHS": {Code: "GHS", NumericCode: "936", MinorUnit: 2, Symbol: "\u20b5", ShowCodeNextToSymbol: false},
+	"GIP": {Code: "GIP", NumericCode: "292", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"GMD": {Code: "GMD", NumericCode: "270", MinorUnit: 2, Symbol: "D", ShowCodeNextToSymbol: false},
+	"GNF": {Code: "GNF", NumericCode: "324", MinorUnit: 0, Symbol: "FG", ShowCodeNextToSymbol: false},
+	"GTQ": {Code: "GTQ", NumericCode: "320", MinorUnit: 2, Symbol: "Q", ShowCodeNextToSymbol: false},
+	"GYD": {Code: "GYD", NumericCode: "328", MinorUnit: 2, Symbol: "G$", ShowCodeNextToSymbol: false},
+	"HKD": {Code: "HKD", NumericCode: "344", MinorUnit: 2, Symbol: "HK$", ShowCodeNextToSymbol: false},
+	"HNL": {Code: "HNL", NumericCode: "340", MinorUnit: 2, Symbol: "L", ShowCodeNextToSymbol: true},
+	"HRK": {Code: "HRK", NumericCode: "191", MinorUnit: 2, Symbol: "kn", ShowCodeNextToSymbol: false, ValidTo: isoDate(2023, time.January, 1)},
+	"HTG": {Code: "HTG", NumericCode: "332", MinorUnit: 2, Symbol: "G", ShowCodeNextToSymbol: false},
+	"HUF": {Code: "HUF", NumericCode: "348", MinorUnit: 0, Symbol: "Ft", ShowCodeNextToSymbol: false},
+	"IDR": {Code: "IDR", NumericCode: "360", MinorUnit: 2, Symbol: "Rp", ShowCodeNextToSymbol: false},
+	"ILS": {Code: "ILS", NumericCode: "376", MinorUnit: 2, Symbol: "\u20aa", ShowCodeNextToSymbol: false},
 	"IMP": {Code: "IMP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"INR": {Code: "INR", MinorUnit: 2, Symbol: "\u20b9", ShowCodeNextToSymbol: false},
-	"IQD": {Code: "IQD", MinorUnit: 3, Symbol: ".\u062f.\u0639", ShowCodeNextToSymbol: false},
-	"IRR": {Code: "IRR", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
-	"ISK": {Code: "ISK", MinorUnit: 0, Symbol: "kr", ShowCodeNextToSymbol: true},
+	"INR": {Code: "INR", NumericCode: "356", MinorUnit: 2, Symbol: "\u20b9", ShowCodeNextToSymbol: false},
+	"IQD": {Code: "IQD", NumericCode: "368", MinorUnit: 3, Symbol: ".\u062f.\u0639", ShowCodeNextToSymbol: false},
+	"IRR": {Code: "IRR", NumericCode: "364", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
+	"ISK": {Code: "ISK", NumericCode: "352", MinorUnit: 0, Symbol: "kr", ShowCodeNextToSymbol: true},
 	"JEP": {Code: "JEP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"JMD": {Code: "JMD", MinorUnit: 2, Symbol: "J$", ShowCodeNextToSymbol: false},
-	"JOD": {Code: "JOD", MinorUnit: 3, Symbol: "\u062f\u002e\u0625", ShowCodeNextToSymbol: true},
-	"JPY": {Code: "JPY", MinorUnit: 0, Symbol: "\u00a5", ShowCodeNextToSymbol: false},
-	"KES": {Code: "KES", MinorUnit: 2, Symbol: "KSh", ShowCodeNextToSymbol: false},
-	"KGS": {Code: "KGS", MinorUnit: 2, Symbol: "\u0441\u043e\u043c", ShowCodeNextToSymbol: false},
-	"KHR": {Code: "KHR", MinorUnit: 2, Symbol: "\u17db", ShowCodeNextToSymbol: false},
-	"KMF": {Code: "KMF", MinorUnit: 0, Symbol: "CF", ShowCodeNextToSymbol: false},
-	"KPW": {Code: "KPW", MinorUnit: 0, Symbol: "\u20a9", ShowCodeNextToSymbol: true},
-	"KRW": {Code: "KRW", MinorUnit: 0, Symbol: "\u20a9", ShowCodeNextToSymbol: true},
-	"KWD": {Code: "KWD", MinorUnit: 3, Symbol: "\u062f\u002e\u0643", ShowCodeNextToSymbol: false},
-	"KYD": {Code: "KYD", MinorUnit: 2, Symbol: "CI$", ShowCodeNextToSymbol: false},
-	"KZT": {Code: "KZT", MinorUnit: 2, Symbol: "\u20b8", ShowCodeNextToSymbol: false},
-	"LAK": {Code: "LAK", MinorUnit: 2, Symbol: "\u20ad", ShowCodeNextToSymbol: false},
-	"LBP": {Code: "LBP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"LKR": {Code: "LKR", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
-	"LRD": {Code: "LRD", MinorUnit: 2, Symbol: "L$", ShowCodeNextToSymbol: false},
-	"LSL": {Code: "LSL", MinorUnit: 2, Symbol: "L", ShowCodeNextToSymbol: true},
-	"LYD": {Code: "LYD", MinorUnit: 3, Symbol: ".\u062f.\u0644", ShowCodeNextToSymbol: false},
-	"MAD": {Code: "MAD", MinorUnit: 2, Symbol: ".\u062f.\u0645", ShowCodeNextToSymbol: false},
-	"MDL": {Code: "MDL", MinorUnit: 2, Symbol: "lei", ShowCodeNextToSymbol: true},
-	"MGA": {Code: "MGA", MinorUnit: 0, Symbol: "Ar", ShowCodeNextToSymbol: false},
-	"MKD": {Code: "MKD", MinorUnit: 2, Symbol: "\u0434\u0435\u043d", ShowCodeNextToSymbol: false},
-	"MMK": {Code: "MMK", MinorUnit: 2, Symbol: "K", ShowCodeNextToSymbol: true},
-	"MNT": {Code: "MNT", MinorUnit: 2, Symbol: "\u20ae", ShowCodeNextToSymbol: false},
-	"MOP": {Code: "MOP", MinorUnit: 2, Symbol: "P", ShowCodeNextToSymbol: true},
-	"MRO": {Code: "MRO", MinorUnit: 0, Symbol: "UM", ShowCodeNextToSymbol: false},
-	"MUR": {Code: "MUR", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
-	"MVR": {Code: "MVR", MinorUnit: 2, Symbol: "MVR", ShowCodeNextToSymbol: false},
-	"MWK": {Code: "MWK", MinorUnit: 2, Symbol: "MK", ShowCodeNextToSymbol: false},
-	"MXN": {Code: "MXN", MinorUnit: 2, Symbol: "Mex$", ShowCodeNextToSymbol: false},
-	"MYR": {Code: "MYR", MinorUnit: 2, Symbol: "RM", ShowCodeNextToSymbol: false},
-	"MZN": {Code: "MZN", MinorUnit: 2, Symbol: "MT", ShowCodeNextToSymbol: false},
-	"NAD": {Code: "NAD", MinorUnit: 2, Symbol: "N$", ShowCodeNextToSymbol: false},
-	"NGN": {Code: "NGN", MinorUnit: 2, Symbol: "\u20a6", ShowCodeNextToSymbol: false},
-	"NIO": {Code: "NIO", MinorUnit: 2, Symbol: "C$", ShowCodeNextToSymbol: false},
-	"NOK": {Code: "NOK", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
-	"NPR": {Code: "NPR", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
-	"NZD": {Code: "NZD", MinorUnit: 2, Symbol: "NZ$", ShowCodeNextToSymbol: false},
-	"OMR": {Code: "OMR", MinorUnit: 3, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
-	"PAB": {Code: "PAB", MinorUnit: 2, Symbol: "B/.", ShowCodeNextToSymbol: false},
-	"PEN": {Code: "PEN", MinorUnit: 2, Symbol: "S/", ShowCodeNextToSymbol: false},
-	"PGK": {Code: "PGK", MinorUnit: 2, Symbol: "K", ShowCodeNextToSymbol: true},
-	"PHP": {Code: "PHP", MinorUnit: 2, Symbol: "\u20b1", ShowCodeNextToSymbol: false},
-	"PKR": {Code: "PKR", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
-	"PLN": {Code: "PLN", MinorUnit: 2, Symbol: "z\u0142", ShowCodeNextToSymbol: false},
-	"PYG": {Code: "PYG", MinorUnit: 0, Symbol: "Gs", ShowCodeNextToSymbol: false},
-	"QAR": {Code: "QAR", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
-	"RON": {Code: "RON", MinorUnit: 2, Symbol: "lei", ShowCodeNextToSymbol: true},
-	"RSD": {Code: "RSD", MinorUnit: 2, Symbol: "\u0414\u0438\u043d.", ShowCodeNextToSymbol: false},
-	"RUB": {Code: "RUB", MinorUnit: 2, Symbol: "\u20bd", ShowCodeNextToSymbol: false},
-	"RWF": {Code: "RWF", MinorUnit: 0, Symbol: "FRw", ShowCodeNextToSymbol: false},
-	"SAR": {Code: "SAR", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
-	"SBD": {Code: "SBD", MinorUnit: 2, Symbol: "SI$", ShowCodeNextToSymbol: false},
-	"SCR": {Code: "SCR", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
-	"SDG": {Code: "SDG", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"SEK": {Code: "SEK", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
-	"SGD": {Code: "SGD", MinorUnit: 2, Symbol: "S$", ShowCodeNextToSymbol: false},
-	"SHP": {Code: "SHP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"SLL": {Code: "SLL", MinorUnit: 2, Symbol: "Le", ShowCodeNextToSymbol: false},
-	"SOS": {Code: "SOS", MinorUnit: 2, Symbol: "Sh", ShowCodeNextToSymbol: false},
-	"SRD": {Code: "SRD", MinorUnit: 2, Symbol: "SRD", ShowCodeNextToSymbol: false},
-	"SSP": {Code: "SSP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"STD": {Code: "STD", MinorUnit: 2, Symbol: "Db", ShowCodeNextToSymbol: false},
-	"SVC": {Code: "SVC", MinorUnit: 2, Symbol: "\u20a1", ShowCodeNextToSymbol: true},
-	"SYP": {Code: "SYP", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"SZL": {Code: "SZL", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
-	"THB": {Code: "THB", MinorUnit: 2, Symbol: "\u0e3f", ShowCodeNextToSymbol: false},
-	"TJS": {Code: "TJS", MinorUnit: 2, Symbol: "SM", ShowCodeNextToSymbol: false},
-	"TMT": {Code: "TMT", MinorUnit: 2, Symbol: "T", ShowCodeNextToSymbol: true},
-	"TND": {Code: "TND", MinorUnit: 3, Symbol: ".\u062f.\u062a", ShowCodeNextToSymbol: false},
-	"TOP": {Code: "TOP", MinorUnit: 2, Symbol: "T$", ShowCodeNextToSymbol: false},
-	"TRY": {Code: "TRY", MinorUnit: 2, Symbol: "\u20ba", ShowCodeNextToSymbol: false},
-	"TTD": {Code: "TTD", MinorUnit: 2, Symbol: "TT$", ShowCodeNextToSymbol: false},
-	"TWD": {Code: "TWD", MinorUnit: 0, Symbol: "NT$", ShowCodeNextToSymbol: false},
-	"TZS": {Code: "TZS", MinorUnit: 0, Symbol: "TSh", ShowCodeNextToSymbol: false},
-	"UAH": {Code: "UAH", MinorUnit: 2, Symbol: "\u20b4", ShowCodeNextToSymbol: false},
-	"UGX": {Code: "UGX", MinorUnit: 0, Symbol: "USh", ShowCodeNextToSymbol: false},
-	"USD": {Code: "USD", MinorUnit: 2, Symbol: "$", ShowCodeNextToSymbol: false},
-	"UYU": {Code: "UYU", MinorUnit: 2, Symbol: "$U", ShowCodeNextToSymbol: false},
-	"UZS": {Code: "UZS", MinorUnit: 2, Symbol: "so\u2019m", ShowCodeNextToSymbol: false},
-	"VES": {Code: "VES", MinorUnit: 2, Symbol: "Bs.S", ShowCodeNextToSymbol: false},
-	"VEF": {Code: "VEF", MinorUnit: 2, Symbol: "Bs.F", ShowCodeNextToSymbol: false},
-	"VND": {Code: "VND", MinorUnit: 0, Symbol: "\u20ab", ShowCodeNextToSymbol: false},
-	"VUV": {Code: "VUV", MinorUnit: 0, Symbol: "Vt", ShowCodeNextToSymbol: false},
-	"WST": {Code: "WST", MinorUnit: 2, Symbol: "T", ShowCodeNextToSymbol: true},
-	"XAF": {Code: "XAF", MinorUnit: 0, Symbol: "Fr", ShowCodeNextToSymbol: true},
-	"XOF": {Code: "XOF", MinorUnit: 0, Symbol: "Fr", ShowCodeNextToSymbol: true},
-	"XPF": {Code: "XPF", MinorUnit: 2, Symbol: "Fr", ShowCodeNextToSymbol: true},
-	"XCD": {Code: "XCD", MinorUnit: 2, Symbol: "EC$", ShowCodeNextToSymbol: false},
-	"YER": {Code: "YER", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
-	"ZAR": {Code: "ZAR", MinorUnit: 2, Symbol: "R", ShowCodeNextToSymbol: false},
-	"ZMW": {Code: "ZMW", MinorUnit: 2, Symbol: "ZK", ShowCodeNextToSymbol: false},
-	"ZWD": {Code: "ZWD", MinorUnit: 2, Symbol: "Z$", ShowCodeNextToSymbol: false},
+	"JMD": {Code: "JMD", NumericCode: "388", MinorUnit: 2, Symbol: "J$", ShowCodeNextToSymbol: false},
+	"JOD": {Code: "JOD", NumericCode: "400", MinorUnit: 3, Symbol: "\u062f\u002e\u0625", ShowCodeNextToSymbol: true},
+	"JPY": {Code: "JPY", NumericCode: "392", MinorUnit: 0, Symbol: "\u00a5", ShowCodeNextToSymbol: false},
+	"KES": {Code: "KES", NumericCode: "404", MinorUnit: 2, Symbol: "KSh", ShowCodeNextToSymbol: false},
+	"KGS": {Code: "KGS", NumericCode: "417", MinorUnit: 2, Symbol: "\u0441\u043e\u043c", ShowCodeNextToSymbol: false},
+	"KHR": {Code: "KHR", NumericCode: "116", MinorUnit: 2, Symbol: "\u17db", ShowCodeNextToSymbol: false},
+	"KMF": {Code: "KMF", NumericCode: "174", MinorUnit: 0, Symbol: "CF", ShowCodeNextToSymbol: false},
+	"KPW": {Code: "KPW", NumericCode: "408", MinorUnit: 0, Symbol: "\u20a9", ShowCodeNextToSymbol: true},
+	"KRW": {Code: "KRW", NumericCode: "410", MinorUnit: 0, Symbol: "\u20a9", ShowCodeNextToSymbol: true},
+	"KWD": {Code: "KWD", NumericCode: "414", MinorUnit: 3, Symbol: "\u062f\u002e\u0643", ShowCodeNextToSymbol: false},
+	"KYD": {Code: "KYD", NumericCode: "136", MinorUnit: 2, Symbol: "CI$", ShowCodeNextToSymbol: false},
+	"KZT": {Code: "KZT", NumericCode: "398", MinorUnit: 2, Symbol: "\u20b8", ShowCodeNextToSymbol: false},
+	"LAK": {Code: "LAK", NumericCode: "418", MinorUnit: 2, Symbol: "\u20ad", ShowCodeNextToSymbol: false},
+	"LBP": {Code: "LBP", NumericCode: "422", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"LKR": {Code: "LKR", NumericCode: "144", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
+	"LRD": {Code: "LRD", NumericCode: "430", MinorUnit: 2, Symbol: "L$", ShowCodeNextToSymbol: false},
+	"LSL": {Code: "LSL", NumericCode: "426", MinorUnit: 2, Symbol: "L", ShowCodeNextToSymbol: true},
+	"LYD": {Code: "LYD", NumericCode: "434", MinorUnit: 3, Symbol: ".\u062f.\u0644", ShowCodeNextToSymbol: false},
+	"MAD": {Code: "MAD", NumericCode: "504", MinorUnit: 2, Symbol: ".\u062f.\u0645", ShowCodeNextToSymbol: false},
+	"MDL": {Code: "MDL", NumericCode: "498", MinorUnit: 2, Symbol: "lei", ShowCodeNextToSymbol: true},
+	"MGA": {Code: "MGA", NumericCode: "969", MinorUnit: 0, Symbol: "Ar", ShowCodeNextToSymbol: false},
+	"MKD": {Code: "MKD", NumericCode: "807", MinorUnit: 2, Symbol: "\u0434\u0435\u043d", ShowCodeNextToSymbol: false},
+	"MMK": {Code: "MMK", NumericCode: "104", MinorUnit: 2, Symbol: "K", ShowCodeNextToSymbol: true},
+	"MNT": {Code: "MNT", NumericCode: "496", MinorUnit: 2, Symbol: "\u20ae", ShowCodeNextToSymbol: false},
+	"MOP": {Code: "MOP", NumericCode: "446", MinorUnit: 2, Symbol: "P", ShowCodeNextToSymbol: true},
+	"MRO": {Code: "MRO", NumericCode: "478", MinorUnit: 0, Symbol: "UM", ShowCodeNextToSymbol: false, ValidTo: isoDate(2018, time.January, 1)},
+	"MRU": {Code: "MRU", NumericCode: "929", MinorUnit: 2, Symbol: "UM", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2018, time.January, 1)},
+	"MUR": {Code: "MUR", NumericCode: "480", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
+	"MVR": {Code: "MVR", NumericCode: "462", MinorUnit: 2, Symbol: "MVR", ShowCodeNextToSymbol: false},
+	"MWK": {Code: "MWK", NumericCode: "454", MinorUnit: 2, Symbol: "MK", ShowCodeNextToSymbol: false},
+	"MXN": {Code: "MXN", NumericCode: "484", MinorUnit: 2, Symbol: "Mex$", ShowCodeNextToSymbol: false},
+	"MXV": {Code: "MXV", NumericCode: "979", MinorUnit: 2, Symbol: "MXV", ShowCodeNextToSymbol: false},
+	"MYR": {Code: "MYR", NumericCode: "458", MinorUnit: 2, Symbol: "RM", ShowCodeNextToSymbol: false},
+	"MZN": {Code: "MZN", NumericCode: "943", MinorUnit: 2, Symbol: "MT", ShowCodeNextToSymbol: false},
+	"NAD": {Code: "NAD", NumericCode: "516", MinorUnit: 2, Symbol: "N$", ShowCodeNextToSymbol: false},
+	"NGN": {Code: "NGN", NumericCode: "566", MinorUnit: 2, Symbol: "\u20a6", ShowCodeNextToSymbol: false},
+	"NIO": {Code: "NIO", NumericCode: "558", MinorUnit: 2, Symbol: "C$", ShowCodeNextToSymbol: false},
+	"NOK": {Code: "NOK", NumericCode: "578", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
+	"NPR": {Code: "NPR", NumericCode: "524", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
+	// NZD cash is rounded to 0.10 and not 0.05, the 5 cent coin was withdrawn in 2006
+	"NZD": {Code: "NZD", NumericCode: "554", MinorUnit: 2, Symbol: "NZ$", ShowCodeNextToSymbol: false, CashRounding: 10},
+	"OMR": {Code: "OMR", NumericCode: "512", MinorUnit: 3, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
+	"PAB": {Code: "PAB", NumericCode: "590", MinorUnit: 2, Symbol: "B/.", ShowCodeNextToSymbol: false},
+	"PEN": {Code: "PEN", NumericCode: "604", MinorUnit: 2, Symbol: "S/", ShowCodeNextToSymbol: false},
+	"PGK": {Code: "PGK", NumericCode: "598", MinorUnit: 2, Symbol: "K", ShowCodeNextToSymbol: true},
+	"PHP": {Code: "PHP", NumericCode: "608", MinorUnit: 2, Symbol: "\u20b1", ShowCodeNextToSymbol: false},
+	"PKR": {Code: "PKR", NumericCode: "586", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
+	"PLN": {Code: "PLN", NumericCode: "985", MinorUnit: 2, Symbol: "z\u0142", ShowCodeNextToSymbol: false},
+	"PYG": {Code: "PYG", NumericCode: "600", MinorUnit: 0, Symbol: "Gs", ShowCodeNextToSymbol: false},
+	"QAR": {Code: "QAR", NumericCode: "634", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
+	"RON": {Code: "RON", NumericCode: "946", MinorUnit: 2, Symbol: "lei", ShowCodeNextToSymbol: true},
+	"RSD": {Code: "RSD", NumericCode: "941", MinorUnit: 2, Symbol: "\u0414\u0438\u043d.", ShowCodeNextToSymbol: false},
+	"RUB": {Code: "RUB", NumericCode: "643", MinorUnit: 2, Symbol: "\u20bd", ShowCodeNextToSymbol: false},
+	"RWF": {Code: "RWF", NumericCode: "646", MinorUnit: 0, Symbol: "FRw", ShowCodeNextToSymbol: false},
+	"SAR": {Code: "SAR", NumericCode: "682", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
+	"SBD": {Code: "SBD", NumericCode: "090", MinorUnit: 2, Symbol: "SI$", ShowCodeNextToSymbol: false},
+	"SCR": {Code: "SCR", NumericCode: "690", MinorUnit: 2, Symbol: "\u20a8", ShowCodeNextToSymbol: true},
+	"SDG": {Code: "SDG", NumericCode: "938", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"SEK": {Code: "SEK", NumericCode: "752", MinorUnit: 2, Symbol: "kr", ShowCodeNextToSymbol: true},
+	"SGD": {Code: "SGD", NumericCode: "702", MinorUnit: 2, Symbol: "S$", ShowCodeNextToSymbol: false},
+	"SHP": {Code: "SHP", NumericCode: "654", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"SLL": {Code: "SLL", NumericCode: "694", MinorUnit: 2, Symbol: "Le", ShowCodeNextToSymbol: false},
+	"SOS": {Code: "SOS", NumericCode: "706", MinorUnit: 2, Symbol: "Sh", ShowCodeNextToSymbol: false},
+	"SRD": {Code: "SRD", NumericCode: "968", MinorUnit: 2, Symbol: "SRD", ShowCodeNextToSymbol: false},
+	"SSP": {Code: "SSP", NumericCode: "728", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"STD": {Code: "STD", NumericCode: "678", MinorUnit: 2, Symbol: "Db", ShowCodeNextToSymbol: false, ValidTo: isoDate(2018, time.January, 1)},
+	"STN": {Code: "STN", NumericCode: "930", MinorUnit: 2, Symbol: "Db", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2018, time.January, 1)},
+	"SVC": {Code: "SVC", NumericCode: "222", MinorUnit: 2, Symbol: "\u20a1", ShowCodeNextToSymbol: true},
+	"SYP": {Code: "SYP", NumericCode: "760", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"SZL": {Code: "SZL", NumericCode: "748", MinorUnit: 2, Symbol: "\u00a3", ShowCodeNextToSymbol: true},
+	"THB": {Code: "THB", NumericCode: "764", MinorUnit: 2, Symbol: "\u0e3f", ShowCodeNextToSymbol: false},
+	"TJS": {Code: "TJS", NumericCode: "972", MinorUnit: 2, Symbol: "SM", ShowCodeNextToSymbol: false},
+	"TMT": {Code: "TMT", NumericCode: "934", MinorUnit: 2, Symbol: "T", ShowCodeNextToSymbol: true},
+	"TND": {Code: "TND", NumericCode: "788", MinorUnit: 3, Symbol: ".\u062f.\u062a", ShowCodeNextToSymbol: false},
+	"TOP": {Code: "TOP", NumericCode: "776", MinorUnit: 2, Symbol: "T$", ShowCodeNextToSymbol: false},
+	"TRY": {Code: "TRY", NumericCode: "949", MinorUnit: 2, Symbol: "\u20ba", ShowCodeNextToSymbol: false},
+	"TTD": {Code: "TTD", NumericCode: "780", MinorUnit: 2, Symbol: "TT$", ShowCodeNextToSymbol: false},
+	"TWD": {Code: "TWD", NumericCode: "901", MinorUnit: 0, Symbol: "NT$", ShowCodeNextToSymbol: false},
+	"TZS": {Code: "TZS", NumericCode: "834", MinorUnit: 0, Symbol: "TSh", ShowCodeNextToSymbol: false},
+	"UAH": {Code: "UAH", NumericCode: "980", MinorUnit: 2, Symbol: "\u20b4", ShowCodeNextToSymbol: false},
+	"UGX": {Code: "UGX", NumericCode: "800", MinorUnit: 0, Symbol: "USh", ShowCodeNextToSymbol: false},
+	"USD": {Code: "USD", NumericCode: "840", MinorUnit: 2, Symbol: "$", ShowCodeNextToSymbol: false},
+	"USN": {Code: "USN", NumericCode: "997", MinorUnit: 2, Symbol: "USN", ShowCodeNextToSymbol: false},
+	"UYI": {Code: "UYI", NumericCode: "940", MinorUnit: 0, Symbol: "UYI", ShowCodeNextToSymbol: false},
+	"UYU": {Code: "UYU", NumericCode: "858", MinorUnit: 2, Symbol: "$U", ShowCodeNextToSymbol: false},
+	"UYW": {Code: "UYW", NumericCode: "927", MinorUnit: 4, Symbol: "UYW", ShowCodeNextToSymbol: false},
+	"UZS": {Code: "UZS", NumericCode: "860", MinorUnit: 2, Symbol: "so\u2019m", ShowCodeNextToSymbol: false},
+	"VES": {Code: "VES", NumericCode: "928", MinorUnit: 2, Symbol: "Bs.S", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2018, time.August, 20)},
+	"VEF": {Code: "VEF", NumericCode: "937", MinorUnit: 2, Symbol: "Bs.F", ShowCodeNextToSymbol: false, ValidTo: isoDate(2018, time.August, 20)},
+	"VND": {Code: "VND", NumericCode: "704", MinorUnit: 0, Symbol: "\u20ab", ShowCodeNextToSymbol: false},
+	"VUV": {Code: "VUV", NumericCode: "548", MinorUnit: 0, Symbol: "Vt", ShowCodeNextToSymbol: false},
+	"WST": {Code: "WST", NumericCode: "882", MinorUnit: 2, Symbol: "T", ShowCodeNextToSymbol: true},
+	"XAF": {Code: "XAF", NumericCode: "950", MinorUnit: 0, Symbol: "Fr", ShowCodeNextToSymbol: true},
+	"XOF": {Code: "XOF", NumericCode: "952", MinorUnit: 0, Symbol: "Fr", ShowCodeNextToSymbol: true},
+	"XPF": {Code: "XPF", NumericCode: "953", MinorUnit: 2, Symbol: "Fr", ShowCodeNextToSymbol: true},
+	"XCD": {Code: "XCD", NumericCode: "951", MinorUnit: 2, Symbol: "EC$", ShowCodeNextToSymbol: false},
+	"YER": {Code: "YER", NumericCode: "886", MinorUnit: 2, Symbol: "\ufdfc", ShowCodeNextToSymbol: true},
+	"ZAR": {Code: "ZAR", NumericCode: "710", MinorUnit: 2, Symbol: "R", ShowCodeNextToSymbol: false},
+	"ZMW": {Code: "ZMW", NumericCode: "967", MinorUnit: 2, Symbol: "ZK", ShowCodeNextToSymbol: false},
+	"ZWD": {Code: "ZWD", NumericCode: "716", MinorUnit: 2, Symbol: "Z$", ShowCodeNextToSymbol: false, ValidTo: isoDate(2008, time.August, 1)},
+	"ZWG": {Code: "ZWG", NumericCode: "924", MinorUnit: 2, Symbol: "ZiG", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2024, time.June, 25)},
+	"ZWR": {Code: "ZWR", NumericCode: "935", MinorUnit: 2, Symbol: "Z$", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2008, time.August, 1), ValidTo: isoDate(2009, time.February, 2)},
+	"ZWL": {Code: "ZWL", NumericCode: "932", MinorUnit: 2, Symbol: "Z$", ShowCodeNextToSymbol: false, ValidFrom: isoDate(2009, time.February, 2), ValidTo: isoDate(2024, time.September, 1)},
+}
+
+// isoDate returns the UTC midnight of a day, it's used for the validity dates of the catalog
+func isoDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 }
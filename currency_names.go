@@ -0,0 +1,312 @@
+package money
+
+import (
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// currencyName holds the CLDR display name and the plural forms of a currency in a language,
+// an empty form falls back to other and other falls back to name
+type currencyName struct {
+	name  string
+	one   string
+	few   string
+	many  string
+	other string
+}
+
+// DisplayName returns the localized name of the currency like "Euro" for "en" or "евро" for "ru".
+// The English name is used for the languages without data and the code for the currencies without a name
+func (c Currency) DisplayName(locale string) string {
+	n, ok := lookupCurrencyName(c.Code, locale)
+	if !ok {
+		return c.Code
+	}
+
+	return n.name
+}
+
+// PluralName returns the localized name of the currency for count units, e.g. "euros" for 2 and "en",
+// the form is chosen by the CLDR plural rules of the locale
+func (c Currency) PluralName(locale string, count int64) string {
+	n, ok := lookupCurrencyName(c.Code, locale)
+	if !ok {
+		return c.Code
+	}
+
+	if count < 0 {
+		count = -count
+	}
+	// the rules look only at the last 6 digits of the integer part
+	form := plural.Cardinal.MatchPlural(language.Make(locale), int(count%1000000), 0, 0, 0, 0)
+	switch {
+	case form == plural.One && n.one != "":
+		return n.one
+	case form == plural.Few && n.few != "":
+		return n.few
+	case form == plural.Many && n.many != "":
+		return n.many
+	case n.other != "":
+		return n.other
+	}
+
+	return n.name
+}
+
+func lookupCurrencyName(code, locale string) (n currencyName, ok bool) {
+	base, _ := language.Make(locale).Base()
+	code = strings.ToUpper(code)
+	if n, ok = currencyNames[base.String()][code]; ok {
+		return n, ok
+	}
+	n, ok = currencyNames["en"][code]
+
+	return n, ok
+}
+
+// currencyNames are taken from CLDR, English covers all the built-in currencies,
+// the other languages the most traded ones
+var currencyNames = map[string]map[string]currencyName{
+	"en": {
+		"AED": {name: "United Arab Emirates Dirham", one: "UAE dirham", other: "UAE dirhams"},
+		"AFN": {name: "Afghan Afghani", one: "Afghan Afghani", other: "Afghan Afghanis"},
+		"ALL": {name: "Albanian Lek", one: "Albanian lek", other: "Albanian lekë"},
+		"AMD": {name: "Armenian Dram", one: "Armenian dram", other: "Armenian drams"},
+		"ANG": {name: "Netherlands Antillean Guilder", one: "Netherlands Antillean guilder", other: "Netherlands Antillean guilders"},
+		"AOA": {name: "Angolan Kwanza", one: "Angolan kwanza", other: "Angolan kwanzas"},
+		"ARS": {name: "Argentine Peso", one: "Argentine peso", other: "Argentine pesos"},
+		"AUD": {name: "Australian Dollar", one: "Australian dollar", other: "Australian dollars"},
+		"AWG": {name: "Aruban Florin", other: "Aruban florin"},
+		"AZN": {name: "Azerbaijani Manat", one: "Azerbaijani manat", other: "Azerbaijani manats"},
+		"BAM": {name: "Bosnia-Herzegovina Convertible Mark", one: "Bosnia-Herzegovina convertible mark", other: "Bosnia-Herzegovina convertible marks"},
+		"BBD": {name: "Barbadian Dollar", one: "Barbadian dollar", other: "Barbadian dollars"},
+		"BDT": {name: "Bangladeshi Taka", one: "Bangladeshi taka", other: "Bangladeshi takas"},
+		"BGN": {name: "Bulgarian Lev", one: "Bulgarian lev", other: "Bulgarian leva"},
+		"BHD": {name: "Bahraini Dinar", one: "Bahraini dinar", other: "Bahraini dinars"},
+		"BIF": {name: "Burundian Franc", one: "Burundian franc", other: "Burundian francs"},
+		"BMD": {name: "Bermudan Dollar", one: "Bermudan dollar", other: "Bermudan dollars"},
+		"BND": {name: "Brunei Dollar", one: "Brunei dollar", other: "Brunei dollars"},
+		"BOB": {name: "Bolivian Boliviano", one: "Bolivian boliviano", other: "Bolivian bolivianos"},
+		"BOV": {name: "Bolivian Mvdol", one: "Bolivian mvdol", other: "Bolivian mvdols"},
+		"BRL": {name: "Brazilian Real", one: "Brazilian real", other: "Brazilian reals"},
+		"BSD": {name: "Bahamian Dollar", one: "Bahamian dollar", other: "Bahamian dollars"},
+		"BTN": {name: "Bhutanese Ngultrum", one: "Bhutanese ngultrum", other: "Bhutanese ngultrums"},
+		"BWP": {name: "Botswanan Pula", one: "Botswanan pula", other: "Botswanan pulas"},
+		"BYN": {name: "Belarusian Ruble", one: "Belarusian ruble", other: "Belarusian rubles"},
+		"BZD": {name: "Belize Dollar", one: "Belize dollar", other: "Belize dollars"},
+		"CAD": {name: "Canadian Dollar", one: "Canadian dollar", other: "Canadian dollars"},
+		"CDF": {name: "Congolese Franc", one: "Congolese franc", other: "Congolese francs"},
+		"CHE": {name: "WIR Euro", one: "WIR euro", other: "WIR euros"},
+		"CHF": {name: "Swiss Franc", one: "Swiss franc", other: "Swiss francs"},
+		"CHW": {name: "WIR Franc", one: "WIR franc", other: "WIR francs"},
+		"CLF": {name: "Chilean Unit of Account (UF)", one: "Chilean unit of account (UF)", other: "Chilean units of account (UF)"},
+		"CLP": {name: "Chilean Peso", one: "Chilean peso", other: "Chilean pesos"},
+		"CNY": {name: "Chinese Yuan", other: "Chinese yuan"},
+		"COP": {name: "Colombian Peso", one: "Colombian peso", other: "Colombian pesos"},
+		"COU": {name: "Colombian Real Value Unit", one: "Colombian real value unit", other: "Colombian real value units"},
+		"CRC": {name: "Costa Rican Colón", one: "Costa Rican colón", other: "Costa Rican colóns"},
+		"CUC": {name: "Cuban Convertible Peso", one: "Cuban convertible peso", other: "Cuban convertible pesos"},
+		"CUP": {name: "Cuban Peso", one: "Cuban peso", other: "Cuban pesos"},
+		"CVE": {name: "Cape Verdean Escudo", one: "Cape Verdean escudo", other: "Cape Verdean escudos"},
+		"CZK": {name: "Czech Koruna", one: "Czech koruna", other: "Czech korunas"},
+		"DJF": {name: "Djiboutian Franc", one: "Djiboutian franc", other: "Djiboutian francs"},
+		"DKK": {name: "Danish Krone", one: "Danish krone", other: "Danish kroner"},
+		"DOP": {name: "Dominican Peso", one: "Dominican peso", other: "Dominican pesos"},
+		"DZD": {name: "Algerian Dinar", one: "Algerian dinar", other: "Algerian dinars"},
+		"EGP": {name: "Egyptian Pound", one: "Egyptian pound", other: "Egyptian pounds"},
+		"ERN": {name: "Eritrean Nakfa", one: "Eritrean nakfa", other: "Eritrean nakfas"},
+		"ETB": {name: "Ethiopian Birr", one: "Ethiopian birr", other: "Ethiopian birrs"},
+		"EUR": {name: "Euro", one: "euro", other: "euros"},
+		"FJD": {name: "Fijian Dollar", one: "Fijian dollar", other: "Fijian dollars"},
+		"FKP": {name: "Falkland Islands Pound", one: "Falkland Islands pound", other: "Falkland Islands pounds"},
+		"GBP": {name: "British Pound", one: "British pound", other: "British pounds"},
+		"GEL": {name: "Georgian Lari", one: "Georgian lari", other: "Georgian laris"},
+		"GGP": {name: "Guernsey Pound", one: "Guernsey pound", other: "Guernsey pounds"},
+		"GHS": {name: "Ghanaian Cedi", one: "Ghanaian cedi", other: "Ghanaian cedis"},
+		"GIP": {name: "Gibraltar Pound", one: "Gibraltar pound", other: "Gibraltar pounds"},
+		"GMD": {name: "Gambian Dalasi", one: "Gambian dalasi", other: "Gambian dalasis"},
+		"GNF": {name: "Guinean Franc", one: "Guinean franc", other: "Guinean francs"},
+		"GTQ": {name: "Guatemalan Quetzal", one: "Guatemalan quetzal", other: "Guatemalan quetzals"},
+		"GYD": {name: "Guyanaese Dollar", one: "Guyanaese dollar", other: "Guyanaese dollars"},
+		"HKD": {name: "Hong Kong Dollar", one: "Hong Kong dollar", other: "Hong Kong dollars"},
+		"HNL": {name: "Honduran Lempira", one: "Honduran lempira", other: "Honduran lempiras"},
+		"HRK": {name: "Croatian Kuna", one: "Croatian kuna", other: "Croatian kunas"},
+		"HTG": {name: "Haitian Gourde", one: "Haitian gourde", other: "Haitian gourdes"},
+		"HUF": {name: "Hungarian Forint", one: "Hungarian forint", other: "Hungarian forints"},
+		"IDR": {name: "Indonesian Rupiah", one: "Indonesian rupiah", other: "Indonesian rupiahs"},
+		"ILS": {name: "Israeli New Shekel", one: "Israeli new shekel", other: "Israeli new shekels"},
+		"IMP": {name: "Manx Pound", one: "Manx pound", other: "Manx pounds"},
+		"INR": {name: "Indian Rupee", one: "Indian rupee", other: "Indian rupees"},
+		"IQD": {name: "Iraqi Dinar", one: "Iraqi dinar", other: "Iraqi dinars"},
+		"IRR": {name: "Iranian Rial", one: "Iranian rial", other: "Iranian rials"},
+		"ISK": {name: "Icelandic Króna", one: "Icelandic króna", other: "Icelandic krónur"},
+		"JEP": {name: "Jersey Pound", one: "Jersey pound", other: "Jersey pounds"},
+		"JMD": {name: "Jamaican Dollar", one: "Jamaican dollar", other: "Jamaican dollars"},
+		"JOD": {name: "Jordanian Dinar", one: "Jordanian dinar", other: "Jordanian dinars"},
+		"JPY": {name: "Japanese Yen", other: "Japanese yen"},
+		"KES": {name: "Kenyan Shilling", one: "Kenyan shilling", other: "Kenyan shillings"},
+		"KGS": {name: "Kyrgystani Som", one: "Kyrgystani som", other: "Kyrgystani soms"},
+		"KHR": {name: "Cambodian Riel", one: "Cambodian riel", other: "Cambodian riels"},
+		"KMF": {name: "Comorian Franc", one: "Comorian franc", other: "Comorian francs"},
+		"KPW": {name: "North Korean Won", other: "North Korean won"},
+		"KRW": {name: "South Korean Won", other: "South Korean won"},
+		"KWD": {name: "Kuwaiti Dinar", one: "Kuwaiti dinar", other: "Kuwaiti dinars"},
+		"KYD": {name: "Cayman Islands Dollar", one: "Cayman Islands dollar", other: "Cayman Islands dollars"},
+		"KZT": {name: "Kazakhstani Tenge", one: "Kazakhstani tenge", other: "Kazakhstani tenges"},
+		"LAK": {name: "Laotian Kip", one: "Laotian kip", other: "Laotian kips"},
+		"LBP": {name: "Lebanese Pound", one: "Lebanese pound", other: "Lebanese pounds"},
+		"LKR": {name: "Sri Lankan Rupee", one: "Sri Lankan rupee", other: "Sri Lankan rupees"},
+		"LRD": {name: "Liberian Dollar", one: "Liberian dollar", other: "Liberian dollars"},
+		"LSL": {name: "Lesotho Loti", one: "Lesotho loti", other: "Lesotho lotis"},
+		"LYD": {name: "Libyan Dinar", one: "Libyan dinar", other: "Libyan dinars"},
+		"MAD": {name: "Moroccan Dirham", one: "Moroccan dirham", other: "Moroccan dirhams"},
+		"MDL": {name: "Moldovan Leu", one: "Moldovan leu", other: "Moldovan lei"},
+		"MGA": {name: "Malagasy Ariary", one: "Malagasy ariary", other: "Malagasy ariaries"},
+		"MKD": {name: "Macedonian Denar", one: "Macedonian denar", other: "Macedonian denari"},
+		"MMK": {name: "Myanmar Kyat", one: "Myanmar kyat", other: "Myanmar kyats"},
+		"MNT": {name: "Mongolian Tugrik", one: "Mongolian tugrik", other: "Mongolian tugriks"},
+		"MOP": {name: "Macanese Pataca", one: "Macanese pataca", other: "Macanese patacas"},
+		"MRO": {name: "Mauritanian Ouguiya (1973–2017)", one: "Mauritanian ouguiya (1973–2017)", other: "Mauritanian ouguiyas (1973–2017)"},
+		"MRU": {name: "Mauritanian Ouguiya", one: "Mauritanian ouguiya", other: "Mauritanian ouguiyas"},
+		"MUR": {name: "Mauritian Rupee", one: "Mauritian rupee", other: "Mauritian rupees"},
+		"MVR": {name: "Maldivian Rufiyaa", one: "Maldivian rufiyaa", other: "Maldivian rufiyaas"},
+		"MWK": {name: "Malawian Kwacha", one: "Malawian kwacha", other: "Malawian kwachas"},
+		"MXN": {name: "Mexican Peso", one: "Mexican peso", other: "Mexican pesos"},
+		"MXV": {name: "Mexican Investment Unit", one: "Mexican investment unit", other: "Mexican investment units"},
+		"MYR": {name: "Malaysian Ringgit", one: "Malaysian ringgit", other: "Malaysian ringgits"},
+		"MZN": {name: "Mozambican Metical", one: "Mozambican metical", other: "Mozambican meticals"},
+		"NAD": {name: "Namibian Dollar", one: "Namibian dollar", other: "Namibian dollars"},
+		"NGN": {name: "Nigerian Naira", one: "Nigerian naira", other: "Nigerian nairas"},
+		"NIO": {name: "Nicaraguan Córdoba", one: "Nicaraguan córdoba", other: "Nicaraguan córdobas"},
+		"NOK": {name: "Norwegian Krone", one: "Norwegian krone", other: "Norwegian kroner"},
+		"NPR": {name: "Nepalese Rupee", one: "Nepalese rupee", other: "Nepalese rupees"},
+		"NZD": {name: "New Zealand Dollar", one: "New Zealand dollar", other: "New Zealand dollars"},
+		"OMR": {name: "Omani Rial", one: "Omani rial", other: "Omani rials"},
+		"PAB": {name: "Panamanian Balboa", one: "Panamanian balboa", other: "Panamanian balboas"},
+		"PEN": {name: "Peruvian Sol", one: "Peruvian sol", other: "Peruvian soles"},
+		"PGK": {name: "Papua New Guinean Kina", other: "Papua New Guinean kina"},
+		"PHP": {name: "Philippine Peso", one: "Philippine peso", other: "Philippine pesos"},
+		"PKR": {name: "Pakistani Rupee", one: "Pakistani rupee", other: "Pakistani rupees"},
+		"PLN": {name: "Polish Zloty", one: "Polish zloty", other: "Polish zlotys"},
+		"PYG": {name: "Paraguayan Guarani", one: "Paraguayan guarani", other: "Paraguayan guaranis"},
+		"QAR": {name: "Qatari Riyal", one: "Qatari riyal", other: "Qatari riyals"},
+		"RON": {name: "Romanian Leu", one: "Romanian leu", other: "Romanian lei"},
+		"RSD": {name: "Serbian Dinar", one: "Serbian dinar", other: "Serbian dinars"},
+		"RUB": {name: "Russian Ruble", one: "Russian ruble", other: "Russian rubles"},
+		"RWF": {name: "Rwandan Franc", one: "Rwandan franc", other: "Rwandan francs"},
+		"SAR": {name: "Saudi Riyal", one: "Saudi riyal", other: "Saudi riyals"},
+		"SBD": {name: "Solomon Islands Dollar", one: "Solomon Islands dollar", other: "Solomon Islands dollars"},
+		"SCR": {name: "Seychellois Rupee", one: "Seychellois rupee", other: "Seychellois rupees"},
+		"SDG": {name: "Sudanese Pound", one: "Sudanese pound", other: "Sudanese pounds"},
+		"SEK": {name: "Swedish Krona", one: "Swedish krona", other: "Swedish kronor"},
+		"SGD": {name: "Singapore Dollar", one: "Singapore dollar", other: "Singapore dollars"},
+		"SHP": {name: "St. Helena Pound", one: "St. Helena pound", other: "St. Helena pounds"},
+		"SLL": {name: "Sierra Leonean Leone", one: "Sierra Leonean leone", other: "Sierra Leonean leones"},
+		"SOS": {name: "Somali Shilling", one: "Somali shilling", other: "Somali shillings"},
+		"SRD": {name: "Surinamese Dollar", one: "Surinamese dollar", other: "Surinamese dollars"},
+		"SSP": {name: "South Sudanese Pound", one: "South Sudanese pound", other: "South Sudanese pounds"},
+		"STD": {name: "São Tomé & Príncipe Dobra (1977–2017)", one: "São Tomé & Príncipe dobra (1977–2017)", other: "São Tomé & Príncipe dobras (1977–2017)"},
+		"STN": {name: "São Tomé & Príncipe Dobra", one: "São Tomé & Príncipe dobra", other: "São Tomé & Príncipe dobras"},
+		"SVC": {name: "Salvadoran Colón", one: "Salvadoran colón", other: "Salvadoran colones"},
+		"SYP": {name: "Syrian Pound", one: "Syrian pound", other: "Syrian pounds"},
+		"SZL": {name: "Swazi Lilangeni", one: "Swazi lilangeni", other: "Swazi emalangeni"},
+		"THB": {name: "Thai Baht", other: "Thai baht"},
+		"TJS": {name: "Tajikistani Somoni", one: "Tajikistani somoni", other: "Tajikistani somonis"},
+		"TMT": {name: "Turkmenistani Manat", other: "Turkmenistani manat"},
+		"TND": {name: "Tunisian Dinar", one: "Tunisian dinar", other: "Tunisian dinars"},
+		"TOP": {name: "Tongan Paʻanga", other: "Tongan paʻanga"},
+		"TRY": {name: "Turkish Lira"},
+		"TTD": {name: "Trinidad & Tobago Dollar", one: "Trinidad & Tobago dollar", other: "Trinidad & Tobago dollars"},
+		"TWD": {name: "New Taiwan Dollar", one: "New Taiwan dollar", other: "New Taiwan dollars"},
+		"TZS": {name: "Tanzanian Shilling", one: "Tanzanian shilling", other: "Tanzanian shillings"},
+		"UAH": {name: "Ukrainian Hryvnia", one: "Ukrainian hryvnia", other: "Ukrainian hryvnias"},
+		"UGX": {name: "Ugandan Shilling", one: "Ugandan shilling", other: "Ugandan shillings"},
+		"USD": {name: "US Dollar", one: "US dollar", other: "US dollars"},
+		"USN": {name: "US Dollar (Next day)", one: "US dollar (Next day)", other: "US dollars (next day)"},
+		"UYI": {name: "Uruguayan Peso (Indexed Units)", one: "Uruguayan peso (Indexed units)", other: "Uruguayan pesos (indexed units)"},
+		"UYU": {name: "Uruguayan Peso", one: "Uruguayan peso", other: "Uruguayan pesos"},
+		"UYW": {name: "Uruguayan Nominal Wage Index Unit", one: "Uruguayan nominal wage index unit", other: "Uruguayan nominal wage index units"},
+		"UZS": {name: "Uzbekistani Som", other: "Uzbekistani som"},
+		"VES": {name: "Venezuelan Bolívar", one: "Venezuelan bolívar", other: "Venezuelan bolívars"},
+		"VEF": {name: "Venezuelan Bolívar (2008–2018)", one: "Venezuelan bolívar (2008–2018)", other: "Venezuelan bolívars (2008–2018)"},
+		"VND": {name: "Vietnamese Dong", other: "Vietnamese dong"},
+		"VUV": {name: "Vanuatu Vatu", one: "Vanuatu vatu", other: "Vanuatu vatus"},
+		"WST": {name: "Samoan Tala", other: "Samoan tala"},
+		"XAF": {name: "Central African CFA Franc", one: "Central African CFA franc", other: "Central African CFA francs"},
+		"XOF": {name: "West African CFA Franc", one: "West African CFA franc", other: "West African CFA francs"},
+		"XPF": {name: "CFP Franc", one: "CFP franc", other: "CFP francs"},
+		"XCD": {name: "East Caribbean Dollar", one: "East Caribbean dollar", other: "East Caribbean dollars"},
+		"YER": {name: "Yemeni Rial", one: "Yemeni rial", other: "Yemeni rials"},
+		"ZAR": {name: "South African Rand", other: "South African rand"},
+		"ZMW": {name: "Zambian Kwacha", one: "Zambian kwacha", other: "Zambian kwachas"},
+		"ZWD": {name: "Zimbabwean Dollar (1980–2008)", one: "Zimbabwean dollar (1980–2008)", other: "Zimbabwean dollars (1980–2008)"},
+		"ZWG": {name: "Zimbabwean Gold", one: "Zimbabwean gold", other: "Zimbabwean gold"},
+		"ZWR": {name: "Zimbabwean Dollar (2008)", one: "Zimbabwean dollar (2008)", other: "Zimbabwean dollars (2008)"},
+		"ZWL": {name: "Zimbabwean Dollar (2009–2024)", one: "Zimbabwean dollar (2009–2024)", other: "Zimbabwean dollars (2009–2024)"},
+	},
+	"de": {
+		"CHF": {name: "Schweizer Franken"},
+		"CNY": {name: "Renminbi Yuan", one: "Chinesischer Yuan", other: "Chinesische Yuan"},
+		"EUR": {name: "Euro"},
+		"GBP": {name: "Britisches Pfund", one: "Britisches Pfund", other: "Britische Pfund"},
+		"JPY": {name: "Japanischer Yen", one: "Japanischer Yen", other: "Japanische Yen"},
+		"RUB": {name: "Russischer Rubel", one: "Russischer Rubel", other: "Russische Rubel"},
+		"USD": {name: "US-Dollar"},
+	},
+	"es": {
+		"CHF": {name: "franco suizo", one: "franco suizo", other: "francos suizos"},
+		"CNY": {name: "yuan", one: "yuan", other: "yuanes"},
+		"EUR": {name: "euro", one: "euro", other: "euros"},
+		"GBP": {name: "libra esterlina", one: "libra esterlina", other: "libras esterlinas"},
+		"JPY": {name: "yen", one: "yen", other: "yenes"},
+		"RUB": {name: "rublo ruso", one: "rublo ruso", other: "rublos rusos"},
+		"USD": {name: "dólar estadounidense", one: "dólar estadounidense", other: "dólares estadounidenses"},
+	},
+	"fr": {
+		"CHF": {name: "franc suisse", one: "franc suisse", other: "francs suisses"},
+		"CNY": {name: "yuan renminbi chinois", one: "yuan renminbi chinois", other: "yuans renminbi chinois"},
+		"EUR": {name: "euro", one: "euro", other: "euros"},
+		"GBP": {name: "livre sterling", one: "livre sterling", other: "livres sterling"},
+		"JPY": {name: "yen japonais", one: "yen japonais", other: "yens japonais"},
+		"RUB": {name: "rouble russe", one: "rouble russe", other: "roubles russes"},
+		"USD": {name: "dollar des États-Unis", one: "dollar des États-Unis", other: "dollars des États-Unis"},
+	},
+	"it": {
+		"CHF": {name: "franco svizzero", one: "franco svizzero", other: "franchi svizzeri"},
+		"CNY": {name: "renminbi cinese", one: "renminbi cinese", other: "renminbi cinesi"},
+		"EUR": {name: "euro"},
+		"GBP": {name: "sterlina britannica", one: "sterlina britannica", other: "sterline britanniche"},
+		"JPY": {name: "yen giapponese", one: "yen giapponese", other: "yen giapponesi"},
+		"RUB": {name: "rublo russo", one: "rublo russo", other: "rubli russi"},
+		"USD": {name: "dollaro statunitense", one: "dollaro statunitense", other: "dollari statunitensi"},
+	},
+	"ja": {
+		"CHF": {name: "スイス フラン"},
+		"CNY": {name: "中国人民元"},
+		"EUR": {name: "ユーロ"},
+		"GBP": {name: "英国ポンド"},
+		"JPY": {name: "日本円", other: "円"},
+		"RUB": {name: "ロシア ルーブル"},
+		"USD": {name: "米ドル"},
+	},
+	"ru": {
+		"CHF": {name: "швейцарский франк", one: "швейцарский франк", few: "швейцарских франка", many: "швейцарских франков", other: "швейцарского франка"},
+		"CNY": {name: "китайский юань", one: "китайский юань", few: "китайских юаня", many: "китайских юаней", other: "китайского юаня"},
+		"EUR": {name: "евро"},
+		"GBP": {name: "британский фунт стерлингов", one: "британский фунт стерлингов", few: "британских фунта стерлингов", many: "британских фунтов стерлингов", other: "британского фунта стерлингов"},
+		"JPY": {name: "японская иена", one: "японская иена", few: "японские иены", many: "японских иен", other: "японской иены"},
+		"RUB": {name: "российский рубль", one: "российский рубль", few: "российских рубля", many: "российских рублей", other: "российского рубля"},
+		"USD": {name: "доллар США", one: "доллар США", many: "долларов США", other: "доллара США"},
+	},
+	"zh": {
+		"CHF": {name: "瑞士法郎"},
+		"CNY": {name: "人民币"},
+		"EUR": {name: "欧元"},
+		"GBP": {name: "英镑"},
+		"JPY": {name: "日元"},
+		"RUB": {name: "俄罗斯卢布"},
+		"USD": {name: "美元"},
+	},
+}
@@ -0,0 +1,60 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_DisplayName(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+
+	assert.Equal(t, "Euro", eur.DisplayName("en"))
+	assert.Equal(t, "euro", eur.DisplayName("it"))
+	assert.Equal(t, "Euro", eur.DisplayName("de-AT"))
+	assert.Equal(t, "евро", eur.DisplayName("ru"))
+	assert.Equal(t, "ユーロ", eur.DisplayName("ja"))
+	assert.Equal(t, "欧元", eur.DisplayName("zh-Hans"))
+	assert.Equal(t, "US Dollar", money.MustGetCurrencyByISOCode("USD").DisplayName("en-US"))
+
+	// English for the languages and currencies without data
+	assert.Equal(t, "Swiss Franc", money.MustGetCurrencyByISOCode("CHF").DisplayName("pt"))
+	assert.Equal(t, "Thai Baht", money.MustGetCurrencyByISOCode("THB").DisplayName("it"))
+	assert.Equal(t, "PTS", money.Currency{Code: "PTS"}.DisplayName("en"))
+}
+
+func TestCurrency_PluralName(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+	usd := money.MustGetCurrencyByISOCode("USD")
+	rub := money.MustGetCurrencyByISOCode("RUB")
+
+	tests := []struct {
+		c      money.Currency
+		locale string
+		count  int64
+		want   string
+	}{
+		{eur, "en", 1, "euro"},
+		{eur, "en", 2, "euros"},
+		{eur, "en", 0, "euros"},
+		{eur, "it", 5, "euro"},
+		{usd, "it", 1, "dollaro statunitense"},
+		{usd, "it", 5, "dollari statunitensi"},
+		{usd, "fr", 0, "dollar des États-Unis"},
+		{usd, "fr", 2, "dollars des États-Unis"},
+		{rub, "ru", 1, "российский рубль"},
+		{rub, "ru", 21, "российский рубль"},
+		{rub, "ru", 3, "российских рубля"},
+		{rub, "ru", 5, "российских рублей"},
+		{rub, "ru", 11, "российских рублей"},
+		{rub, "ru", -2, "российских рубля"},
+		{usd, "ja", 3, "米ドル"},
+		{money.MustGetCurrencyByISOCode("JPY"), "ja", 3, "円"},
+		{money.MustGetCurrencyByISOCode("JPY"), "en", 3, "Japanese yen"},
+		{money.Currency{Code: "PTS"}, "en", 3, "PTS"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.c.PluralName(tt.locale, tt.count), "%s %s %d", tt.c.Code, tt.locale, tt.count)
+	}
+}
@@ -0,0 +1,93 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyByNumericCode(t *testing.T) {
+	tests := []struct {
+		code interface{}
+		want string
+	}{
+		{"978", "EUR"},
+		{" 840 ", "USD"},
+		{"008", "ALL"},
+		{8, "ALL"},
+		{int64(36), "AUD"},
+	}
+	for _, tt := range tests {
+		c, err := money.CurrencyByNumericCode(tt.code)
+		assert.Nil(t, err, tt.code)
+		assert.Equal(t, tt.want, c.Code)
+	}
+
+	_, err := money.CurrencyByNumericCode("999")
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{Code: "999"}))
+	_, err = money.CurrencyByNumericCode("EUR")
+	assert.NotNil(t, err)
+	_, err = money.CurrencyByNumericCode(9.78)
+	assert.NotNil(t, err)
+	_, err = money.CurrencyByNumericCode("36")
+	assert.NotNil(t, err, "only ints are zero padded")
+	_, err = money.CurrencyByNumericCode(1036)
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "978", money.MustGetCurrencyByISOCode("EUR").NumericCode)
+	assert.Equal(t, "", money.MustGetCurrencyByISOCode("GGP").NumericCode)
+}
+
+func TestNumericCode_parseAndScan(t *testing.T) {
+	m, err := money.Parse("978 1250")
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(1250), m)
+
+	m, err = money.Forge(1250, "840")
+	assert.Nil(t, err)
+	assert.Equal(t, money.USD(1250), m)
+
+	m, err = money.Scan(int64(100), "392")
+	assert.Nil(t, err)
+	assert.Equal(t, money.JPY(100), m)
+
+	var scanned money.Money
+	assert.Nil(t, scanned.Scan("826 99"))
+	assert.Equal(t, money.GBP(99), scanned)
+
+	// only exactly 3 digits are a numeric code
+	for _, s := range []string{"12 345", "8 100", "36 1", "0978 1"} {
+		_, err = money.Parse(s)
+		assert.NotNil(t, err, s)
+	}
+	_, err = money.CurrencyByISOCode("36")
+	assert.NotNil(t, err)
+	_, err = money.Forge(1, "8")
+	assert.NotNil(t, err)
+	_, err = money.Scan(int64(1), "36")
+	assert.NotNil(t, err)
+
+	var unmarshaled money.Money
+	assert.Nil(t, unmarshaled.UnmarshalJSON([]byte(`{"amount":5,"currency":"756"}`)))
+	assert.Equal(t, money.CHF(5), unmarshaled)
+}
+
+func TestRegistry_numericCode(t *testing.T) {
+	r := money.MustNewRegistry(money.Currency{Code: "PTS", NumericCode: "901", MinorUnit: 0})
+
+	c, err := r.CurrencyByNumericCode(901)
+	assert.Nil(t, err)
+	assert.Equal(t, "PTS", c.Code)
+
+	assert.NotNil(t, r.Register(money.Currency{Code: "CRD", NumericCode: "901"}), "numeric code taken")
+	assert.NotNil(t, r.Register(money.Currency{Code: "CRD", NumericCode: "9a1"}))
+	assert.NotNil(t, r.Register(money.Currency{Code: "123"}), "alpha code can't be numeric")
+	assert.NotNil(t, r.Register(money.Currency{Code: "12"}), "alpha code can't be digits")
+
+	assert.Nil(t, r.Unregister("PTS"))
+	_, err = r.CurrencyByNumericCode("901")
+	assert.NotNil(t, err)
+	assert.Nil(t, r.Register(money.Currency{Code: "CRD", NumericCode: "901"}))
+}
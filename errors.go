@@ -0,0 +1,105 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrOverflow is returned when the result of an operation doesn't fit the int64 Amount
+var ErrOverflow = errors.New("amount overflows int64")
+
+// CurrencyMismatchError is returned when two moneys with different currency are compared or used in math
+type CurrencyMismatchError struct {
+	Left  Currency
+	Right Currency
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("can't compare or use math with different currency: %s and %s", e.Left, e.Right)
+}
+
+// Is matches any *CurrencyMismatchError when target is zero valued, the same currencies otherwise
+func (e *CurrencyMismatchError) Is(target error) bool {
+	t, ok := target.(*CurrencyMismatchError)
+	if !ok {
+		return false
+	}
+
+	if t.Left.Code == "" && t.Right.Code == "" {
+		return true
+	}
+
+	return t.Left.IsEquals(e.Left) && t.Right.IsEquals(e.Right)
+}
+
+// UnknownCurrencyError is returned when a currency code is not found
+type UnknownCurrencyError struct {
+	Code string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("currency not found: code %s", e.Code)
+}
+
+// Is matches any *UnknownCurrencyError when target is zero valued, the same code otherwise
+func (e *UnknownCurrencyError) Is(target error) bool {
+	t, ok := target.(*UnknownCurrencyError)
+	if !ok {
+		return false
+	}
+
+	return t.Code == "" || t.Code == e.Code
+}
+
+// ParseError is returned when a string can't be parsed as money
+// Offset is the byte position in Input where the problem starts
+type ParseError struct {
+	Input  string
+	Offset int
+	Reason string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("can't parse money %q at offset %d: %s", e.Input, e.Offset, e.Reason)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ErrEmpty is returned when an aggregation gets no money at all
+var ErrEmpty = errors.New("no money to aggregate")
+
+// WithdrawnCurrencyError is returned when new money is forged in a currency that isn't legal tender anymore
+// and the registry refuses withdrawn currencies, Replacement is the successor code when known
+type WithdrawnCurrencyError struct {
+	Code        string
+	ValidTo     time.Time
+	Replacement string
+}
+
+func (e *WithdrawnCurrencyError) Error() string {
+	msg := fmt.Sprintf("currency %s is withdrawn since %s", e.Code, e.ValidTo.Format("2006-01-02"))
+	if e.Replacement != "" {
+		msg += ", use " + e.Replacement
+	}
+
+	return msg
+}
+
+// Is matches any *WithdrawnCurrencyError when target is zero valued, the same code otherwise
+func (e *WithdrawnCurrencyError) Is(target error) bool {
+	t, ok := target.(*WithdrawnCurrencyError)
+	if !ok {
+		return false
+	}
+
+	return t.Code == "" || t.Code == e.Code
+}
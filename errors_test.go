@@ -0,0 +1,84 @@
+package money_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	_, err := money.EUR(100).Add(money.USD(100))
+
+	var mismatch *money.CurrencyMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "EUR", mismatch.Left.Code)
+	assert.Equal(t, "USD", mismatch.Right.Code)
+	assert.Equal(t, "can't compare or use math with different currency: EUR and USD", err.Error())
+
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+	assert.False(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.EUR(100).Subtract(money.GBP(100))
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{
+		Left:  money.MustGetCurrencyByISOCode("EUR"),
+		Right: money.MustGetCurrencyByISOCode("GBP"),
+	}))
+	assert.False(t, errors.Is(err, &money.CurrencyMismatchError{
+		Left:  money.MustGetCurrencyByISOCode("EUR"),
+		Right: money.MustGetCurrencyByISOCode("USD"),
+	}))
+}
+
+func TestUnknownCurrencyError(t *testing.T) {
+	_, err := money.Forge(100, "xyz")
+
+	var unknown *money.UnknownCurrencyError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "XYZ", unknown.Code)
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{}))
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{Code: "XYZ"}))
+	assert.False(t, errors.Is(err, &money.UnknownCurrencyError{Code: "ABC"}))
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantOffset int
+		wantReason string
+		wantErr    error
+	}{
+		{"", 0, "empty string", nil},
+		{"EUR 12 34", 7, "money field should be like `EUR 123`", nil},
+		{"  EUR 12.12", 6, "invalid amount", strconv.ErrSyntax},
+		{"USD 99999999999999999999", 4, "invalid amount", strconv.ErrRange},
+		{" XYZ 123", 1, "invalid currency", &money.UnknownCurrencyError{Code: "XYZ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := money.Parse(tt.input)
+
+			var parseErr *money.ParseError
+			assert.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, tt.input, parseErr.Input)
+			assert.Equal(t, tt.wantOffset, parseErr.Offset)
+			assert.Equal(t, tt.wantReason, parseErr.Reason)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestParseError_missingCurrency(t *testing.T) {
+	m := money.Money{}
+	err := m.UnmarshalJSON([]byte(`{"amount":100}`))
+
+	var parseErr *money.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "missing currency", parseErr.Reason)
+	assert.False(t, errors.Is(err, &money.UnknownCurrencyError{}))
+	assert.Equal(t, `can't parse money "{\"amount\":100}" at offset 0: missing currency`, err.Error())
+}
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"errors"
+	"time"
+)
+
+// Interval is the time between two due dates
+type Interval struct {
+	Months int
+	Days   int
+}
+
+var (
+	Weekly    = Interval{Days: 7}
+	BiWeekly  = Interval{Days: 14}
+	Monthly   = Interval{Months: 1}
+	Quarterly = Interval{Months: 3}
+)
+
+// AddTo returns t moved forward by n intervals, months are added keeping the day of t
+// clamped to the end of the month so Jan 31 + 1 month is Feb 28/29
+func (i Interval) AddTo(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(i.Months*n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+
+	return first.AddDate(0, 0, d-1+i.Days*n)
+}
+
+func (i Interval) IsZero() bool {
+	return i.Months == 0 && i.Days == 0
+}
+
+// Installment is an amount due at a date
+type Installment struct {
+	Due    time.Time `json:"due"`
+	Amount Money     `json:"amount"`
+}
+
+// Plan is a pay-in-N plan, DownPayment plus all the Installments is always the planned amount
+type Plan struct {
+	DownPayment  Money         `json:"downPayment"`
+	Installments []Installment `json:"installments"`
+}
+
+// Total returns the down payment plus all the installments
+func (p Plan) Total() Money {
+	t := p.DownPayment
+	for _, i := range p.Installments {
+		t = t.MustAdd(i.Amount)
+	}
+
+	return t
+}
+
+type planOptions struct {
+	remainderLast   bool
+	downPercent     int
+	downPaymentMode RoundingMode
+}
+
+// PlanOption customizes InstallmentPlan
+type PlanOption func(*planOptions)
+
+// WithRemainderFirst puts the leftover minor units on the first installment, it's the default
+func WithRemainderFirst() PlanOption {
+	return func(o *planOptions) { o.remainderLast = false }
+}
+
+// WithRemainderLast puts the leftover minor units on the last installment
+func WithRemainderLast() PlanOption {
+	return func(o *planOptions) { o.remainderLast = true }
+}
+
+// WithDownPayment asks percent (0-100) of the amount up front, rounded with mode
+func WithDownPayment(percent int, mode RoundingMode) PlanOption {
+	return func(o *planOptions) {
+		o.downPercent = percent
+		o.downPaymentMode = mode
+	}
+}
+
+// InstallmentPlan splits the money in an optional down payment and n installments,
+// the first one due at firstDue and the next ones every interval.
+// The installments are equal but one, that gets the leftover minor units, so the plan sums exactly to m
+func (m Money) InstallmentPlan(n int, firstDue time.Time, interval Interval, opts ...PlanOption) (p Plan, err error) {
+	if n <= 0 {
+		return p, errors.New("a plan needs at least one installment")
+	}
+	if m.IsNegative() {
+		return p, errors.New("can't plan a negative amount")
+	}
+	if n > 1 && interval.IsZero() {
+		return p, errors.New("a plan with many installments needs an interval")
+	}
+
+	o := planOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.downPercent < 0 || o.downPercent > 100 {
+		return p, errors.New("down payment percent must be between 0 and 100")
+	}
+
+	p.DownPayment, err = m.MultiplyRatio(int64(o.downPercent), 100, o.downPaymentMode)
+	if err != nil {
+		return Plan{}, err
+	}
+	rest := m.MustSubtract(p.DownPayment)
+
+	each := rest.MustDivide(int64(n), RoundDown)
+	leftover := rest.MustSubtract(each.MustMultiply(int64(n)))
+	withLeftover := 0
+	if o.remainderLast {
+		withLeftover = n - 1
+	}
+
+	p.Installments = make([]Installment, n)
+	for i := range p.Installments {
+		amount := each
+		if i == withLeftover {
+			amount = amount.MustAdd(leftover)
+		}
+		p.Installments[i] = Installment{Due: interval.AddTo(firstDue, i), Amount: amount}
+	}
+
+	return p, err
+}
+
+func (m Money) MustInstallmentPlan(n int, firstDue time.Time, interval Interval, opts ...PlanOption) Plan {
+	p, err := m.InstallmentPlan(n, firstDue, interval, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
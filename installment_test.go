@@ -0,0 +1,66 @@
+package money_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterval_AddTo(t *testing.T) {
+	jan31 := time.Date(2020, time.January, 31, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2020, time.February, 29, 10, 0, 0, 0, time.UTC), money.Monthly.AddTo(jan31, 1))
+	assert.Equal(t, time.Date(2020, time.March, 31, 10, 0, 0, 0, time.UTC), money.Monthly.AddTo(jan31, 2))
+	assert.Equal(t, time.Date(2020, time.April, 30, 10, 0, 0, 0, time.UTC), money.Quarterly.AddTo(jan31, 1))
+	assert.Equal(t, time.Date(2020, time.February, 14, 10, 0, 0, 0, time.UTC), money.BiWeekly.AddTo(jan31, 1))
+	assert.Equal(t, jan31, money.Weekly.AddTo(jan31, 0))
+}
+
+func TestMoney_InstallmentPlan(t *testing.T) {
+	first := time.Date(2020, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	p, err := money.EUR(10000).InstallmentPlan(3, first, money.Monthly)
+	assert.Nil(t, err)
+	assert.True(t, p.DownPayment.IsZero())
+	assert.Equal(t, []money.Installment{
+		{Due: first, Amount: money.EUR(3334)},
+		{Due: time.Date(2020, time.February, 15, 0, 0, 0, 0, time.UTC), Amount: money.EUR(3333)},
+		{Due: time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC), Amount: money.EUR(3333)},
+	}, p.Installments)
+	assert.Equal(t, money.EUR(10000), p.Total())
+
+	p = money.EUR(10001).MustInstallmentPlan(4, first, money.BiWeekly, money.WithRemainderLast())
+	assert.Equal(t, money.EUR(2500), p.Installments[0].Amount)
+	assert.Equal(t, money.EUR(2501), p.Installments[3].Amount)
+	assert.Equal(t, time.Date(2020, time.February, 26, 0, 0, 0, 0, time.UTC), p.Installments[3].Due)
+	assert.Equal(t, money.EUR(10001), p.Total())
+}
+
+func TestMoney_InstallmentPlanDownPayment(t *testing.T) {
+	first := time.Date(2020, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	p, err := money.EUR(9999).InstallmentPlan(3, first, money.Monthly, money.WithDownPayment(25, money.RoundHalfUp))
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(2500), p.DownPayment)
+	assert.Equal(t, money.EUR(2501), p.Installments[0].Amount)
+	assert.Equal(t, money.EUR(2499), p.Installments[1].Amount)
+	assert.Equal(t, money.EUR(9999), p.Total())
+}
+
+func TestMoney_InstallmentPlanErrors(t *testing.T) {
+	first := time.Date(2020, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	_, err := money.EUR(100).InstallmentPlan(0, first, money.Monthly)
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(-100).InstallmentPlan(2, first, money.Monthly)
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(100).InstallmentPlan(2, first, money.Interval{})
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(100).InstallmentPlan(2, first, money.Monthly, money.WithDownPayment(101, money.RoundHalfUp))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,180 @@
+package interest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/radical-app/money"
+)
+
+// DayCount is the convention used to turn a date range in a fraction of year
+type DayCount int
+
+const (
+	// ACT360 actual days over 360
+	ACT360 DayCount = iota
+	// ACT365F actual days over a fixed 365
+	ACT365F
+	// ACTACT actual days over the actual days of each year (ISDA), the days in leap years count 1/366
+	ACTACT
+	// Thirty360 every month has 30 days and the year 360 (bond basis)
+	Thirty360
+)
+
+func (d DayCount) String() string {
+	switch d {
+	case ACT360:
+		return "ACT/360"
+	case ACT365F:
+		return "ACT/365F"
+	case ACTACT:
+		return "ACT/ACT"
+	case Thirty360:
+		return "30/360"
+	}
+
+	return "Unknown"
+}
+
+// YearFraction returns the exact fraction of year between from and to, only the dates are used
+func (d DayCount) YearFraction(from, to time.Time) (*big.Rat, error) {
+	from, to = date(from), date(to)
+	if to.Before(from) {
+		return nil, errors.New("the end date can't be before the start date")
+	}
+
+	switch d {
+	case ACT360:
+		return big.NewRat(days(from, to), 360), nil
+	case ACT365F:
+		return big.NewRat(days(from, to), 365), nil
+	case ACTACT:
+		yf := new(big.Rat)
+		for start := from; start.Before(to); {
+			end := time.Date(start.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			if to.Before(end) {
+				end = to
+			}
+			yf.Add(yf, big.NewRat(days(start, end), daysInYear(start.Year())))
+			start = end
+		}
+		return yf, nil
+	case Thirty360:
+		y1, m1, d1 := from.Date()
+		y2, m2, d2 := to.Date()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+		n := 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+		return big.NewRat(int64(n), 360), nil
+	}
+
+	return nil, fmt.Errorf("unknown day count convention %d", d)
+}
+
+// ParseRate parses an exact annual rate like "5.25%" or "0.0525"
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	percent := strings.HasSuffix(s, "%")
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(strings.TrimSuffix(s, "%")))
+	if !ok {
+		return nil, fmt.Errorf("invalid rate %s", s)
+	}
+	if percent {
+		r.Quo(r, big.NewRat(100, 1))
+	}
+
+	return r, nil
+}
+
+func MustParseRate(s string) *big.Rat {
+	r, err := ParseRate(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Simple returns the simple interest principal * rate * year fraction, rounded with mode
+func Simple(principal money.Money, rate *big.Rat, from, to time.Time, dc DayCount, mode money.RoundingMode) (i money.Money, err error) {
+	yf, err := dc.YearFraction(from, to)
+	if err != nil {
+		return i, err
+	}
+
+	return principal.MultiplyRat(yf.Mul(yf, rate), mode)
+}
+
+func MustSimple(principal money.Money, rate *big.Rat, from, to time.Time, dc DayCount, mode money.RoundingMode) money.Money {
+	i, err := Simple(principal, rate, from, to, dc, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return i
+}
+
+// Compound returns the interest compounded periodsPerYear times a year, rounded with mode.
+// The whole periods are compounded, the last broken period earns simple interest:
+// principal * (1 + rate/n)^k * (1 + rate/n * f) - principal where k + f is the count of periods
+func Compound(principal money.Money, rate *big.Rat, periodsPerYear int, from, to time.Time, dc DayCount, mode money.RoundingMode) (i money.Money, err error) {
+	if periodsPerYear <= 0 {
+		return i, errors.New("periods per year must be positive")
+	}
+	yf, err := dc.YearFraction(from, to)
+	if err != nil {
+		return i, err
+	}
+
+	n := big.NewRat(int64(periodsPerYear), 1)
+	periods := new(big.Rat).Mul(yf, n)
+	whole := new(big.Int).Quo(periods.Num(), periods.Denom())
+	broken := new(big.Rat).Sub(periods, new(big.Rat).SetInt(whole))
+
+	periodRate := new(big.Rat).Quo(rate, n)
+	onePlusRate := new(big.Rat).Add(big.NewRat(1, 1), periodRate)
+
+	// (a/b)^k = a^k / b^k
+	factor := new(big.Rat).SetFrac(
+		new(big.Int).Exp(onePlusRate.Num(), whole, nil),
+		new(big.Int).Exp(onePlusRate.Denom(), whole, nil),
+	)
+	stub := new(big.Rat).Mul(periodRate, broken)
+	factor.Mul(factor, stub.Add(stub, big.NewRat(1, 1)))
+
+	return principal.MultiplyRat(factor.Sub(factor, big.NewRat(1, 1)), mode)
+}
+
+func MustCompound(principal money.Money, rate *big.Rat, periodsPerYear int, from, to time.Time, dc DayCount, mode money.RoundingMode) money.Money {
+	i, err := Compound(principal, rate, periodsPerYear, from, to, dc, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return i
+}
+
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// days counts the days between two dates at midnight UTC
+func days(from, to time.Time) int64 {
+	return (to.Unix() - from.Unix()) / 86400
+}
+
+func daysInYear(year int) int64 {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+
+	return 365
+}
@@ -0,0 +1,92 @@
+package interest
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestDayCount_YearFraction(t *testing.T) {
+	tests := []struct {
+		dc       DayCount
+		from, to string
+		want     *big.Rat
+	}{
+		{ACT360, "2020-01-01", "2020-07-01", big.NewRat(182, 360)},
+		{ACT365F, "2020-01-01", "2020-07-01", big.NewRat(182, 365)},
+		{ACTACT, "2020-01-01", "2020-07-01", big.NewRat(182, 366)},
+		{ACTACT, "2019-07-01", "2020-07-01", new(big.Rat).Add(big.NewRat(184, 365), big.NewRat(182, 366))},
+		{Thirty360, "2020-01-31", "2020-03-31", big.NewRat(60, 360)},
+		{Thirty360, "2020-01-15", "2021-02-28", big.NewRat(403, 360)},
+		{ACT360, "2020-01-01", "2020-01-01", new(big.Rat)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dc.String()+" "+tt.from+" "+tt.to, func(t *testing.T) {
+			got, err := tt.dc.YearFraction(day(tt.from), day(tt.to))
+			assert.Nil(t, err)
+			assert.Equal(t, 0, tt.want.Cmp(got), "got %s want %s", got, tt.want)
+		})
+	}
+
+	_, err := ACT360.YearFraction(day("2020-02-01"), day("2020-01-01"))
+	assert.NotNil(t, err)
+
+	// the time of day is ignored, a later start time on the same day is a zero range
+	got, err := ACT360.YearFraction(day("2020-01-01").Add(18*time.Hour), day("2020-01-01").Add(9*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, got.Sign())
+
+	got, err = ACT365F.YearFraction(day("2020-01-01").Add(23*time.Hour), day("2020-01-02"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, big.NewRat(1, 365).Cmp(got))
+}
+
+func TestParseRate(t *testing.T) {
+	assert.Equal(t, 0, big.NewRat(525, 10000).Cmp(MustParseRate("5.25%")))
+	assert.Equal(t, 0, big.NewRat(525, 10000).Cmp(MustParseRate("0.0525")))
+
+	_, err := ParseRate("five")
+	assert.NotNil(t, err)
+}
+
+func TestSimple(t *testing.T) {
+	rate := MustParseRate("5%")
+
+	i, err := Simple(money.EUR(1000000), rate, day("2020-01-01"), day("2020-07-01"), ACT360, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(25278), i)
+
+	i = MustSimple(money.EUR(1000000), rate, day("2020-01-01"), day("2020-07-01"), ACT365F, money.RoundDown)
+	assert.Equal(t, money.EUR(24931), i)
+}
+
+func TestCompound(t *testing.T) {
+	rate := MustParseRate("12%")
+
+	i, err := Compound(money.EUR(100000), rate, 12, day("2020-01-01"), day("2021-01-01"), Thirty360, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(12683), i)
+
+	// half a month earns simple interest
+	i = MustCompound(money.EUR(100000), rate, 12, day("2020-01-01"), day("2020-01-16"), Thirty360, money.RoundHalfEven)
+	assert.Equal(t, money.EUR(500), i)
+
+	// yearly compounding for one year is simple interest
+	simple := MustSimple(money.EUR(100000), rate, day("2020-01-01"), day("2021-01-01"), ACTACT, money.RoundHalfEven)
+	i = MustCompound(money.EUR(100000), rate, 1, day("2020-01-01"), day("2021-01-01"), ACTACT, money.RoundHalfEven)
+	assert.Equal(t, simple, i)
+
+	_, err = Compound(money.EUR(100000), rate, 0, day("2020-01-01"), day("2021-01-01"), ACTACT, money.RoundHalfEven)
+	assert.NotNil(t, err)
+}
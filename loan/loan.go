@@ -0,0 +1,169 @@
+package loan
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/radical-app/money"
+)
+
+// Kind is the way the principal is paid back
+type Kind int
+
+const (
+	// Annuity (French) every installment has the same payment, the interest part decreases over time
+	Annuity Kind = iota
+	// Linear every installment pays back the same principal, the payment decreases over time
+	Linear
+	// Bullet the installments pay only interest, the whole principal is paid back with the last one
+	Bullet
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Annuity:
+		return "Annuity"
+	case Linear:
+		return "Linear"
+	case Bullet:
+		return "Bullet"
+	}
+
+	return "Unknown"
+}
+
+// Loan describes the borrowed money and how it's paid back
+type Loan struct {
+	Principal money.Money
+	// AnnualRate is the exact nominal annual rate, 5% is 5/100
+	AnnualRate *big.Rat
+	// Term is the count of installments
+	Term int
+	// PaymentsPerYear must divide 12, zero means monthly
+	PaymentsPerYear int
+	FirstPayment    time.Time
+	// Rounding is used for the interest of every installment and for the annuity payment
+	Rounding money.RoundingMode
+}
+
+// Installment is a row of the amortization schedule, Payment is always Principal plus Interest
+type Installment struct {
+	Date             time.Time   `json:"date"`
+	Principal        money.Money `json:"principal"`
+	Interest         money.Money `json:"interest"`
+	Payment          money.Money `json:"payment"`
+	RemainingBalance money.Money `json:"remainingBalance"`
+}
+
+// Schedule returns the installments of the loan, the principals always sum exactly to the loan principal,
+// the last installment absorbs the rounding residue
+func (l Loan) Schedule(kind Kind) (installments []Installment, err error) {
+	if !l.Principal.IsPositive() {
+		return nil, errors.New("loan principal must be positive")
+	}
+	if l.Term <= 0 {
+		return nil, errors.New("loan term must be positive")
+	}
+	if l.AnnualRate == nil || l.AnnualRate.Sign() < 0 {
+		return nil, errors.New("loan rate can't be empty or negative")
+	}
+	perYear := l.PaymentsPerYear
+	if perYear == 0 {
+		perYear = 12
+	}
+	if perYear < 0 || 12%perYear != 0 {
+		return nil, errors.New("payments per year must divide 12")
+	}
+
+	periodRate := new(big.Rat).Quo(l.AnnualRate, big.NewRat(int64(perYear), 1))
+
+	var fixed money.Money
+	switch kind {
+	case Annuity:
+		fixed, err = annuityPayment(l.Principal, periodRate, l.Term, l.Rounding)
+	case Linear:
+		fixed, err = l.Principal.Divide(int64(l.Term), l.Rounding)
+	case Bullet:
+	default:
+		err = errors.New("unknown loan kind")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	balance := l.Principal
+	installments = make([]Installment, l.Term)
+	for i := range installments {
+		interest, err := balance.MultiplyRat(periodRate, l.Rounding)
+		if err != nil {
+			return nil, err
+		}
+
+		var principal money.Money
+		switch {
+		case i == l.Term-1:
+			principal = balance
+		case kind == Annuity:
+			principal, err = fixed.Subtract(interest)
+		case kind == Linear:
+			principal = fixed
+		default:
+			principal = money.ForgeWellFormed(0, balance.Currency)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// a tiny payment can't pay more than what's left
+		if gt, _ := principal.GreaterThan(balance); gt {
+			principal = balance
+		}
+
+		balance, err = balance.Subtract(principal)
+		if err != nil {
+			return nil, err
+		}
+		payment, err := principal.Add(interest)
+		if err != nil {
+			return nil, err
+		}
+
+		installments[i] = Installment{
+			Date:             money.Interval{Months: 12 / perYear}.AddTo(l.FirstPayment, i),
+			Principal:        principal,
+			Interest:         interest,
+			Payment:          payment,
+			RemainingBalance: balance,
+		}
+	}
+
+	return installments, nil
+}
+
+func (l Loan) MustSchedule(kind Kind) []Installment {
+	installments, err := l.Schedule(kind)
+	if err != nil {
+		panic(err)
+	}
+
+	return installments
+}
+
+// annuityPayment returns principal * r / (1 - (1+r)^-n) rounded with mode
+func annuityPayment(principal money.Money, r *big.Rat, n int, mode money.RoundingMode) (money.Money, error) {
+	if r.Sign() == 0 {
+		return principal.Divide(int64(n), mode)
+	}
+
+	onePlusRate := new(big.Rat).Add(big.NewRat(1, 1), r)
+	exp := big.NewInt(int64(n))
+	// f = (1+r)^n, the payment factor is r * f / (f - 1)
+	f := new(big.Rat).SetFrac(
+		new(big.Int).Exp(onePlusRate.Num(), exp, nil),
+		new(big.Int).Exp(onePlusRate.Denom(), exp, nil),
+	)
+	factor := new(big.Rat).Mul(r, f)
+	factor.Quo(factor, f.Sub(f, big.NewRat(1, 1)))
+
+	return principal.MultiplyRat(factor, mode)
+}
@@ -0,0 +1,150 @@
+package loan
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func principalsSumUp(t *testing.T, l Loan, installments []Installment) {
+	sum := money.ForgeWellFormed(0, l.Principal.Currency)
+	for _, i := range installments {
+		sum = sum.MustAdd(i.Principal)
+		assert.True(t, i.Principal.MustAdd(i.Interest).IsEquals(i.Payment))
+	}
+	assert.True(t, sum.IsEquals(l.Principal), "sum %s, principal %s", sum.String(), l.Principal.String())
+	assert.True(t, installments[len(installments)-1].RemainingBalance.IsZero())
+}
+
+func TestLoan_ScheduleAnnuity(t *testing.T) {
+	l := Loan{
+		Principal:    money.EUR(1000000),
+		AnnualRate:   big.NewRat(6, 100),
+		Term:         12,
+		FirstPayment: day("2020-01-31"),
+		Rounding:     money.RoundHalfEven,
+	}
+
+	installments, err := l.Schedule(Annuity)
+	assert.Nil(t, err)
+	assert.Len(t, installments, 12)
+	principalsSumUp(t, l, installments)
+
+	first := installments[0]
+	assert.Equal(t, money.EUR(86066), first.Payment)
+	assert.Equal(t, money.EUR(5000), first.Interest)
+	assert.Equal(t, money.EUR(81066), first.Principal)
+	assert.Equal(t, money.EUR(918934), first.RemainingBalance)
+	for _, i := range installments[:11] {
+		assert.Equal(t, money.EUR(86066), i.Payment)
+	}
+
+	assert.Equal(t, day("2020-02-29"), installments[1].Date)
+	assert.Equal(t, day("2020-03-31"), installments[2].Date)
+	assert.Equal(t, day("2020-12-31"), installments[11].Date)
+}
+
+func TestLoan_ScheduleLinear(t *testing.T) {
+	l := Loan{
+		Principal:       money.EUR(100000),
+		AnnualRate:      big.NewRat(4, 100),
+		Term:            3,
+		PaymentsPerYear: 4,
+		FirstPayment:    day("2020-03-15"),
+		Rounding:        money.RoundHalfUp,
+	}
+
+	installments := l.MustSchedule(Linear)
+	principalsSumUp(t, l, installments)
+
+	assert.Equal(t, money.EUR(33333), installments[0].Principal)
+	assert.Equal(t, money.EUR(1000), installments[0].Interest)
+	assert.Equal(t, money.EUR(33334), installments[2].Principal)
+	assert.Equal(t, money.EUR(333), installments[2].Interest)
+	assert.Equal(t, day("2020-06-15"), installments[1].Date)
+}
+
+func TestLoan_ScheduleBullet(t *testing.T) {
+	l := Loan{
+		Principal:    money.EUR(1000000),
+		AnnualRate:   big.NewRat(6, 100),
+		Term:         3,
+		FirstPayment: day("2020-01-01"),
+	}
+
+	installments := l.MustSchedule(Bullet)
+	principalsSumUp(t, l, installments)
+
+	assert.Equal(t, money.EUR(5000), installments[0].Payment)
+	assert.Equal(t, money.EUR(1000000), installments[0].RemainingBalance)
+	assert.Equal(t, money.EUR(1005000), installments[2].Payment)
+}
+
+func TestLoan_ScheduleZeroRate(t *testing.T) {
+	l := Loan{
+		Principal:    money.EUR(1000),
+		AnnualRate:   new(big.Rat),
+		Term:         3,
+		FirstPayment: day("2020-01-01"),
+	}
+
+	installments := l.MustSchedule(Annuity)
+	principalsSumUp(t, l, installments)
+	assert.Equal(t, money.EUR(333), installments[0].Payment)
+	assert.Equal(t, money.EUR(334), installments[2].Payment)
+}
+
+func TestLoan_ScheduleErrors(t *testing.T) {
+	valid := Loan{Principal: money.EUR(1000), AnnualRate: big.NewRat(1, 100), Term: 3}
+
+	l := valid
+	l.Principal = money.EUR(0)
+	_, err := l.Schedule(Annuity)
+	assert.NotNil(t, err)
+
+	l = valid
+	l.Term = 0
+	_, err = l.Schedule(Annuity)
+	assert.NotNil(t, err)
+
+	l = valid
+	l.PaymentsPerYear = 5
+	_, err = l.Schedule(Annuity)
+	assert.NotNil(t, err)
+
+	l = valid
+	l.AnnualRate = nil
+	_, err = l.Schedule(Annuity)
+	assert.NotNil(t, err)
+
+	_, err = valid.Schedule(Kind(42))
+	assert.NotNil(t, err)
+}
+
+func TestLoan_ScheduleIsolatedRegistry(t *testing.T) {
+	r := money.MustNewRegistry(money.Currency{Code: "PTS", MinorUnit: 0})
+	for _, kind := range []Kind{Annuity, Linear, Bullet} {
+		l := Loan{
+			Principal:    r.MustForge(10000, "PTS"),
+			AnnualRate:   big.NewRat(6, 100),
+			Term:         6,
+			FirstPayment: day("2020-01-31"),
+			Rounding:     money.RoundHalfEven,
+		}
+		installments, err := l.Schedule(kind)
+		assert.Nil(t, err, kind.String())
+		assert.Equal(t, "PTS", installments[0].Payment.Currency.Code, kind.String())
+		principalsSumUp(t, l, installments)
+	}
+}
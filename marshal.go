@@ -2,7 +2,6 @@ package money
 
 import (
 	"encoding/json"
-	"errors"
 )
 
 func (m *Money) MarshalJSON() ([]byte, error) {
@@ -18,7 +17,7 @@ func (m *Money) UnmarshalJSON(data []byte) error {
 	}
 
 	if dto.Currency == "" {
-		return errors.New("invalid money object: empty currency")
+		return &ParseError{Input: string(data), Reason: "missing currency"}
 	}
 
 	var err error
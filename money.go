@@ -3,7 +3,7 @@ package money
 import (
 	"errors"
 	"fmt"
-	"math"
+	"math/big"
 	"strconv"
 
 	"database/sql/driver"
@@ -32,15 +32,17 @@ func (m Money) Float() float64 {
 	return float64(m.Amount) / float64(d)
 }
 
+// AmountAsString returns the exact amount in units with all the minor unit decimals like "12.30"
 func (m Money) AmountAsString() string {
-	return fmt.Sprintf("%."+strconv.Itoa(m.Currency.MinorUnit)+"f", m.Float())
+	return formatMinorUnits(big.NewInt(m.Amount.Int64()), m.Currency.MinorUnit)
 }
 
 // Forge
 // amount   int64   A positive integer in cents
 // currCode string Three-letter ISO currency code, in lowercase
+// it fails with *WithdrawnCurrencyError for a withdrawn currency after RefuseWithdrawnCurrencies(true)
 func Forge(amount int64, currCode string) (m Money, err error) {
-	c, err := CurrencyByISOCode(currCode)
+	c, err := defaultRegistry.forgeCurrency(currCode)
 	if err != nil {
 		return
 	}
@@ -52,29 +54,44 @@ func Forge(amount int64, currCode string) (m Money, err error) {
 // amount   float64 A positive float64 in cents
 // currCode string  Three-letter ISO currency code, in lowercase
 func ForgeFloat(amount float64, currCode string) (m Money, err error) {
-	c, err := CurrencyByISOCode(currCode)
+	c, err := defaultRegistry.forgeCurrency(currCode)
 	if err != nil {
 		return m, err
 	}
 
-	m = ForgeFloatWithCurrency(amount, c)
-	return m, err
+	return ForgeFloatWithCurrency(amount, c)
 }
 
 func MustForgeFloat(amountFloat float64, currCode string) Money {
-	m, err := Forge(0, currCode)
+	m, err := ForgeFloat(amountFloat, currCode)
 	if err != nil {
 		panic(err)
 	}
 
-	return ForgeFloatWithCurrency(amountFloat, m.Currency)
+	return m
 }
 
-func ForgeFloatWithCurrency(amountFloat float64, c Currency) Money {
+// ForgeFloatWithCurrency
+// amountFloat float64  The amount in units, it's rounded to the nearest minor unit
+// currency    Currency The currency Value Object, kept like ForgeWellFormed
+// returns ErrOverflow when the amount in minor units doesn't fit int64
+func ForgeFloatWithCurrency(amountFloat float64, c Currency) (m Money, err error) {
 	fd := float64(c.GetCents())
-	amount := int64(math.Round(fd * amountFloat))
+	amount, err := floatToInt64(fd * amountFloat)
+	if err != nil {
+		return m, err
+	}
+
+	return ForgeWellFormed(amount, c), err
+}
+
+func MustForgeFloatWithCurrency(amountFloat float64, c Currency) Money {
+	m, err := ForgeFloatWithCurrency(amountFloat, c)
+	if err != nil {
+		panic(err)
+	}
 
-	return ForgeWithCurrency(amount, c)
+	return m
 }
 
 // MustForge Forge or panic
@@ -104,6 +121,17 @@ func ForgeWithCurrency(amount int64, c Currency) Money {
 	return Money{Amount: Amount(amount), Currency: c}
 }
 
+// ForgeWellFormed is ForgeWithCurrency for any well formed currency, e.g. the one of an isolated Registry
+// or of an existing money, the currency isn't looked up. Only a currency that isn't well formed
+// falls back to DefaultCurrencyCode
+func ForgeWellFormed(amount int64, c Currency) Money {
+	if c.wellFormed() != nil {
+		return ForgeWithCurrency(amount, c)
+	}
+
+	return Money{Amount: Amount(amount), Currency: c}
+}
+
 func (m Money) IsZero() bool {
 	return m.Amount == 0
 }
@@ -116,21 +144,61 @@ func (m Money) IsEquals(cmp Money) bool {
 	return m.Amount == cmp.Amount && m.Currency.IsEquals(cmp.Currency)
 }
 
+// PercentOff returns perc% of the money rounded half up, see ApplyPercent.
+// It panics with ErrOverflow when the result doesn't fit the amount, use TryPercentOff to get the error
 func (m Money) PercentOff(perc int) Money {
-	return m.PercentOffFloat(float64(perc))
+	s, err := m.TryPercentOff(perc)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// TryPercentOff returns perc% of the money rounded half up, or ErrOverflow when the result doesn't fit the amount
+func (m Money) TryPercentOff(perc int) (s Money, err error) {
+	p, err := ForgePercent(int64(perc))
+	if err != nil {
+		return s, err
+	}
+
+	return m.ApplyPercent(p, RoundHalfUp)
 }
 
+// PercentOffFloat returns perc% of the money rounded half up, perc is rounded to a Percent first.
+// It panics with ErrOverflow when perc doesn't fit a Percent or the result doesn't fit the amount,
+// use TryPercentOffFloat to get the error
 func (m Money) PercentOffFloat(perc float64) Money {
-	div := perc / 100
-	return ForgeFloatWithCurrency(m.Float()*div, m.Currency)
+	s, err := m.TryPercentOffFloat(perc)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// TryPercentOffFloat returns perc% of the money rounded half up, perc is rounded to a Percent first.
+// It returns ErrOverflow when perc doesn't fit a Percent or the result doesn't fit the amount
+func (m Money) TryPercentOffFloat(perc float64) (s Money, err error) {
+	p, err := floatToInt64(perc * PercentScale)
+	if err != nil {
+		return s, err
+	}
+
+	return m.ApplyPercent(Percent(p), RoundHalfUp)
 }
 
 func (m Money) Add(addendum Money) (s Money, err error) {
 	if !m.Currency.IsEquals(addendum.Currency) {
-		return s, errors.New(fmt.Sprint("Can't compare or use math with different currency", m.Currency, s.Currency))
+		return s, &CurrencyMismatchError{Left: m.Currency, Right: addendum.Currency}
 	}
 
-	return ForgeWithCurrency(addendum.Amount.Int64()+m.Amount.Int64(), m.Currency), err
+	sum, err := addInt64(m.Amount.Int64(), addendum.Amount.Int64())
+	if err != nil {
+		return s, err
+	}
+
+	return ForgeWellFormed(sum, m.Currency), err
 }
 
 func (m Money) MustAdd(addendum Money) (s Money) {
@@ -144,10 +212,15 @@ func (m Money) MustAdd(addendum Money) (s Money) {
 
 func (m Money) Subtract(subtrahend Money) (s Money, err error) {
 	if !m.Currency.IsEquals(subtrahend.Currency) {
-		return s, errors.New(fmt.Sprint("Can't compare or use math with different currency", m.Currency, s.Currency))
+		return s, &CurrencyMismatchError{Left: m.Currency, Right: subtrahend.Currency}
 	}
 
-	return ForgeWithCurrency(m.Amount.Int64()-subtrahend.Amount.Int64(), m.Currency), err
+	diff, err := subInt64(m.Amount.Int64(), subtrahend.Amount.Int64())
+	if err != nil {
+		return s, err
+	}
+
+	return ForgeWellFormed(diff, m.Currency), err
 }
 
 func (m Money) MustSubtract(subtrahend Money) (s Money) {
@@ -159,6 +232,68 @@ func (m Money) MustSubtract(subtrahend Money) (s Money) {
 	return s
 }
 
+// Multiply returns the money multiplied by an integer factor
+func (m Money) Multiply(factor int64) (s Money, err error) {
+	p, err := mulInt64(m.Amount.Int64(), factor)
+	if err != nil {
+		return s, err
+	}
+
+	return ForgeWellFormed(p, m.Currency), err
+}
+
+func (m Money) MustMultiply(factor int64) (s Money) {
+	s, err := m.Multiply(factor)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// MultiplyRatio returns the money multiplied by num/den, the result is rounded to the minor unit with mode
+// the computation is done on the integer amount only, the float value is never used
+func (m Money) MultiplyRatio(num, den int64, mode RoundingMode) (s Money, err error) {
+	if den == 0 {
+		return s, errors.New("can't multiply by a ratio with zero denominator")
+	}
+
+	n := new(big.Int).Mul(big.NewInt(m.Amount.Int64()), big.NewInt(num))
+	q := quoRound(n, big.NewInt(den), mode)
+	if !q.IsInt64() {
+		return s, ErrOverflow
+	}
+
+	return ForgeWellFormed(q.Int64(), m.Currency), err
+}
+
+func (m Money) MustMultiplyRatio(num, den int64, mode RoundingMode) (s Money) {
+	s, err := m.MultiplyRatio(num, den, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Divide returns the money divided by divisor, the result is rounded to the minor unit with mode
+func (m Money) Divide(divisor int64, mode RoundingMode) (s Money, err error) {
+	if divisor == 0 {
+		return s, errors.New("can't divide by zero")
+	}
+
+	return m.MultiplyRatio(1, divisor, mode)
+}
+
+func (m Money) MustDivide(divisor int64, mode RoundingMode) (s Money) {
+	s, err := m.Divide(divisor, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 func (m Money) SplitAmountAndCents() (i int64, cents int, err error) {
 	f := m.Float()
 	digitsCount := m.Currency.MinorUnit
@@ -196,8 +331,7 @@ func (m Money) MustSplitAmountAndCents() (i int64, cents int) {
 
 func Scan(value interface{}, curr string) (m Money, err error) {
 	if v, ok := value.(int64); ok {
-		mm, err := Forge(v, curr)
-		return mm, err
+		return defaultRegistry.read(v, curr)
 	}
 	return m, fmt.Errorf("impossible to get int64 the value from %v", value)
 }
@@ -206,11 +340,11 @@ func (m *Money) ScanInt64(value interface{}) error {
 	if value == nil {
 		return nil
 	}
-	code := DefaultCurrencyCode
-	if m.Currency.IsValid() {
-		code = m.Currency.Code
+	if v, ok := value.(int64); ok && m.Currency.wellFormed() == nil {
+		*m = ForgeWellFormed(v, m.Currency)
+		return nil
 	}
-	mm, err := Scan(value, code)
+	mm, err := Scan(value, DefaultCurrencyCode)
 	if err != nil {
 		return err
 	}
@@ -233,7 +367,7 @@ func (m *Money) Scan(value interface{}) error {
 	}
 
 	if v, ok := value.(int64); ok {
-		mm := ForgeWithCurrency(v, m.Currency)
+		mm := ForgeWellFormed(v, m.Currency)
 		err := mm.ScanInt64(value)
 		if err != nil {
 			return err
@@ -243,11 +377,10 @@ func (m *Money) Scan(value interface{}) error {
 	}
 
 	if v, ok := value.(float64); ok {
-		code := DefaultCurrencyCode
-		if m.Currency.IsValid() {
-			code = m.Currency.Code
+		mm, err := ForgeFloatWithCurrency(v, m.Currency)
+		if m.Currency.wellFormed() != nil {
+			mm, err = ForgeFloat(v, DefaultCurrencyCode)
 		}
-		mm, err := ForgeFloat(v, code)
 		if err != nil {
 			return err
 		}
@@ -276,7 +409,7 @@ type DTO struct {
 }
 
 func (d DTO) ExtractMoney() (m Money, err error) {
-	return Forge(d.Amount, d.Currency)
+	return defaultRegistry.read(d.Amount, d.Currency)
 }
 
 func (m Money) ExtractDTO() DTO {
@@ -306,6 +439,7 @@ func BIF(i int64) Money { return MustForge(i, "BIF") }
 func BMD(i int64) Money { return MustForge(i, "BMD") }
 func BND(i int64) Money { return MustForge(i, "BND") }
 func BOB(i int64) Money { return MustForge(i, "BOB") }
+func BOV(i int64) Money { return MustForge(i, "BOV") }
 func BRL(i int64) Money { return MustForge(i, "BRL") }
 func BSD(i int64) Money { return MustForge(i, "BSD") }
 func BTN(i int64) Money { return MustForge(i, "BTN") }
@@ -314,11 +448,14 @@ func BYN(i int64) Money { return MustForge(i, "BYN") }
 func BZD(i int64) Money { return MustForge(i, "BZD") }
 func CAD(i int64) Money { return MustForge(i, "CAD") }
 func CDF(i int64) Money { return MustForge(i, "CDF") }
+func CHE(i int64) Money { return MustForge(i, "CHE") }
 func CHF(i int64) Money { return MustForge(i, "CHF") }
+func CHW(i int64) Money { return MustForge(i, "CHW") }
 func CLF(i int64) Money { return MustForge(i, "CLF") }
 func CLP(i int64) Money { return MustForge(i, "CLP") }
 func CNY(i int64) Money { return MustForge(i, "CNY") }
 func COP(i int64) Money { return MustForge(i, "COP") }
+func COU(i int64) Money { return MustForge(i, "COU") }
 func CRC(i int64) Money { return MustForge(i, "CRC") }
 func CUC(i int64) Money { return MustForge(i, "CUC") }
 func CUP(i int64) Money { return MustForge(i, "CUP") }
@@ -382,10 +519,12 @@ func MMK(i int64) Money { return MustForge(i, "MMK") }
 func MNT(i int64) Money { return MustForge(i, "MNT") }
 func MOP(i int64) Money { return MustForge(i, "MOP") }
 func MRO(i int64) Money { return MustForge(i, "MRO") }
+func MRU(i int64) Money { return MustForge(i, "MRU") }
 func MUR(i int64) Money { return MustForge(i, "MUR") }
 func MVR(i int64) Money { return MustForge(i, "MVR") }
 func MWK(i int64) Money { return MustForge(i, "MWK") }
 func MXN(i int64) Money { return MustForge(i, "MXN") }
+func MXV(i int64) Money { return MustForge(i, "MXV") }
 func MYR(i int64) Money { return MustForge(i, "MYR") }
 func MZN(i int64) Money { return MustForge(i, "MZN") }
 func NAD(i int64) Money { return MustForge(i, "NAD") }
@@ -419,6 +558,7 @@ func SOS(i int64) Money { return MustForge(i, "SOS") }
 func SRD(i int64) Money { return MustForge(i, "SRD") }
 func SSP(i int64) Money { return MustForge(i, "SSP") }
 func STD(i int64) Money { return MustForge(i, "STD") }
+func STN(i int64) Money { return MustForge(i, "STN") }
 func SVC(i int64) Money { return MustForge(i, "SVC") }
 func SYP(i int64) Money { return MustForge(i, "SYP") }
 func SZL(i int64) Money { return MustForge(i, "SZL") }
@@ -434,7 +574,10 @@ func TZS(i int64) Money { return MustForge(i, "TZS") }
 func UAH(i int64) Money { return MustForge(i, "UAH") }
 func UGX(i int64) Money { return MustForge(i, "UGX") }
 func USD(i int64) Money { return MustForge(i, "USD") }
+func USN(i int64) Money { return MustForge(i, "USN") }
+func UYI(i int64) Money { return MustForge(i, "UYI") }
 func UYU(i int64) Money { return MustForge(i, "UYU") }
+func UYW(i int64) Money { return MustForge(i, "UYW") }
 func UZS(i int64) Money { return MustForge(i, "UZS") }
 func VES(i int64) Money { return MustForge(i, "VES") }
 func VEF(i int64) Money { return MustForge(i, "VEF") }
@@ -449,6 +592,9 @@ func YER(i int64) Money { return MustForge(i, "YER") }
 func ZAR(i int64) Money { return MustForge(i, "ZAR") }
 func ZMW(i int64) Money { return MustForge(i, "ZMW") }
 func ZWD(i int64) Money { return MustForge(i, "ZWD") }
+func ZWG(i int64) Money { return MustForge(i, "ZWG") }
+func ZWL(i int64) Money { return MustForge(i, "ZWL") }
+func ZWR(i int64) Money { return MustForge(i, "ZWR") }
 
 func FloatAED(i float64) Money { return MustForgeFloat(i, "AED") }
 func FloatAFN(i float64) Money { return MustForgeFloat(i, "AFN") }
@@ -1,6 +1,116 @@
 package money
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MoneySet is an amount with its presentation in another currency, e.g. "charged USD 12.00 (≈ EUR 11.03)".
+// Money is the original amount, MoneyPresenter the converted one and Rate the rate used for the conversion.
+// Use convert.ForgeMoneySet to build it.
 type MoneySet struct {
-	Money   Money
+	Money          Money
 	MoneyPresenter Money
+	Rate           ExchangeRate
+}
+
+// ExchangeRate holds the fields of the convert.Rate used by a MoneySet as given,
+// Rate is the units of Target for one unit of Source and may go either way of the set
+type ExchangeRate struct {
+	Source Currency
+	Target Currency
+	Rate   float64
+}
+
+// MoneySetDTO is the json shape of MoneySet
+type MoneySetDTO struct {
+	Money     DTO             `json:"money"`
+	Presenter DTO             `json:"presenter"`
+	Rate      ExchangeRateDTO `json:"rate"`
+}
+
+// ExchangeRateDTO is the json shape of ExchangeRate
+type ExchangeRateDTO struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	Rate   float64 `json:"rate"`
+}
+
+func (s MoneySet) ExtractDTO() MoneySetDTO {
+	rate := ExchangeRateDTO{s.Rate.Source.Code, s.Rate.Target.Code, s.Rate.Rate}
+
+	return MoneySetDTO{s.Money.ExtractDTO(), s.MoneyPresenter.ExtractDTO(), rate}
+}
+
+func (d MoneySetDTO) ExtractMoneySet() (s MoneySet, err error) {
+	s.Money, err = d.Money.ExtractMoney()
+	if err != nil {
+		return MoneySet{}, err
+	}
+	s.MoneyPresenter, err = d.Presenter.ExtractMoney()
+	if err != nil {
+		return MoneySet{}, err
+	}
+	s.Rate.Rate = d.Rate.Rate
+	if s.Rate.Source, err = CurrencyByISOCode(d.Rate.Source); err != nil {
+		return MoneySet{}, err
+	}
+	if s.Rate.Target, err = CurrencyByISOCode(d.Rate.Target); err != nil {
+		return MoneySet{}, err
+	}
+
+	return s, err
+}
+
+// String returns the original and the presented amount like "USD 12.00 (≈ EUR 11.03)"
+func (s MoneySet) String() string {
+	return fmt.Sprintf("%s %s (≈ %s %s)",
+		s.Money.Currency.Code, s.Money.AmountAsString(),
+		s.MoneyPresenter.Currency.Code, s.MoneyPresenter.AmountAsString(),
+	)
+}
+
+func (s MoneySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ExtractDTO())
+}
+
+func (s *MoneySet) UnmarshalJSON(data []byte) error {
+	dto := MoneySetDTO{}
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+
+	ms, err := dto.ExtractMoneySet()
+	if err != nil {
+		return err
+	}
+	*s = ms
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface, the set is stored as json.
+// Like Money.Scan a NULL leaves the set untouched
+func (s *MoneySet) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return s.UnmarshalJSON([]byte(v))
+	case []byte:
+		return s.UnmarshalJSON(v)
+	case nil:
+		return nil
+	}
+
+	return fmt.Errorf("can't convert given %v", value)
+}
+
+// Value implements the driver Valuer interface, the set is stored as json
+func (s MoneySet) Value() (driver.Value, error) {
+	b, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
 }
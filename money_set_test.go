@@ -0,0 +1,52 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func set() money.MoneySet {
+	rate := money.ExchangeRate{
+		Source: money.MustGetCurrencyByISOCode("USD"),
+		Target: money.MustGetCurrencyByISOCode("EUR"),
+		Rate:   0.9192,
+	}
+
+	return money.MoneySet{Money: money.USD(1200), MoneyPresenter: money.EUR(1103), Rate: rate}
+}
+
+func TestMoneySet_JSON(t *testing.T) {
+	s := set()
+
+	b, err := json.Marshal(s)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"money":{"amount":1200,"currency":"USD","symbol":"$","cents":100},`+
+		`"presenter":{"amount":1103,"currency":"EUR","symbol":"€","cents":100},`+
+		`"rate":{"source":"USD","target":"EUR","rate":0.9192}}`, string(b))
+
+	var got money.MoneySet
+	assert.Nil(t, json.Unmarshal(b, &got))
+	assert.Equal(t, s, got)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"money":{"amount":1,"currency":"XXX"}}`), &got))
+}
+
+func TestMoneySet_SQL(t *testing.T) {
+	s := set()
+
+	v, err := s.Value()
+	assert.Nil(t, err)
+
+	var got money.MoneySet
+	assert.Nil(t, got.Scan(v))
+	assert.Equal(t, s, got)
+	assert.Nil(t, got.Scan([]byte(v.(string))))
+	assert.Equal(t, s, got)
+	assert.NotNil(t, got.Scan(12))
+	assert.Nil(t, got.Scan(nil), "NULL is handled like Money.Scan")
+	assert.Equal(t, s, got)
+	assert.Equal(t, "USD 12.00 (≈ EUR 11.03)", s.String())
+}
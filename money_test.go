@@ -27,6 +27,14 @@ func TestForge(t *testing.T) {
 	assert.Equal(t, OneEur, money.EUR(100))
 }
 
+func TestForgeWithCurrency_unknownFallsBackToDefault(t *testing.T) {
+	m := money.ForgeWithCurrency(100, money.Currency{Code: "xyz", MinorUnit: 2})
+	assert.Equal(t, money.EUR(100), m)
+
+	m = money.ForgeWithCurrency(100, money.Currency{})
+	assert.Equal(t, money.EUR(100), m)
+}
+
 func TestOutputASFloat(t *testing.T) {
 	OneEurAndOneCent, err := money.Forge(101, "EUR")
 	assert.Nil(t, err)
@@ -2,28 +2,35 @@ package moneyfmt
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/radical-app/money"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 )
 
+// DisplayAmount formats the exact amount with the locale separators, trailing zero decimals are dropped.
+// The amount isn't converted to float so currencies with many decimals like BTC keep all their digits
 func DisplayAmount(m money.Money, locale string) (formatted string, err error) {
-	tag := language.Make(locale)
-	p := message.NewPrinter(tag)
-	_, cents, err := m.SplitAmountAndCents()
+	p := message.NewPrinter(language.Make(locale))
+
+	parts := strings.SplitN(m.AmountAsString(), ".", 2)
+	units, err := strconv.ParseInt(parts[0], 10, 64)
 	if err != nil {
 		return formatted, err
 	}
-	floatAmountAsString := p.Sprintf("%f", m.Float())
-	maxI := lastIndexOfCommaOrDot(floatAmountAsString)
-	if cents == 0 && maxI <= len(floatAmountAsString) {
-		return floatAmountAsString[0:maxI], nil
+	formatted = p.Sprintf("%d", units)
+	if units == 0 && m.IsNegative() {
+		formatted = "-" + formatted
 	}
-	centsAsString := fmt.Sprintf("%d", cents)
 
-	return floatAmountAsString[0 : maxI+1+len(centsAsString)], nil
+	if len(parts) == 1 || strings.TrimRight(parts[1], "0") == "" {
+		return formatted, err
+	}
+
+	return formatted + decimalSeparator(p) + strings.TrimRight(parts[1], "0"), err
 }
 
 func MustDisplayAmount(m money.Money, locale string) (formatted string) {
@@ -71,12 +78,16 @@ func MustDisplayISO(m money.Money, locale string) (formatted string) {
 	return formatted
 }
 
-func lastIndexOfCommaOrDot(o string) int {
-	iDot := strings.LastIndex(o, ".")
-	iComma := strings.LastIndex(o, ",")
-	maxI := iComma
-	if iDot > iComma {
-		maxI = iDot
-	}
-	return maxI
+// DisplayPercent formats the percent with the locale pattern, e.g. "12,5 %" with a no-break space for "fr"
+func DisplayPercent(p money.Percent, locale string) string {
+	printer := message.NewPrinter(language.Make(locale))
+
+	return printer.Sprint(number.Percent(p.Float()/100, number.MaxFractionDigits(4)))
+}
+
+// decimalSeparator returns the decimal separator of the printer locale
+func decimalSeparator(p *message.Printer) string {
+	r := []rune(p.Sprintf("%.1f", 1.5))
+
+	return string(r[1 : len(r)-1])
 }
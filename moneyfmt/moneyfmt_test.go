@@ -111,3 +111,19 @@ func TestByLocale(t *testing.T) {
 		return
 	}
 }
+
+func TestDisplayPercent(t *testing.T) {
+	p := money.MustParsePercent("12.5%")
+
+	assert.Equal(t, "12.5%", moneyfmt.DisplayPercent(p, "en"))
+	assert.Equal(t, "12,5\u00a0%", moneyfmt.DisplayPercent(p, "fr"))
+	assert.Equal(t, "12,5\u00a0%", moneyfmt.DisplayPercent(p, "de"))
+	assert.Equal(t, "9.975%", moneyfmt.DisplayPercent(money.MustParsePercent("9.975"), "en"))
+}
+
+func TestDisplayAmount_exact(t *testing.T) {
+	assert.Equal(t, "1,234.05", moneyfmt.MustDisplayAmount(money.EUR(123405), "en"))
+	assert.Equal(t, "-1.234,5", moneyfmt.MustDisplayAmount(money.EUR(-123450), "it"))
+	assert.Equal(t, "-0.5", moneyfmt.MustDisplayAmount(money.EUR(-50), "en"))
+	assert.Equal(t, "1,234", moneyfmt.MustDisplayAmount(money.JPY(1234), "en"))
+}
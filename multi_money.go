@@ -0,0 +1,257 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Converter converts money to another currency, convert.Rates implements it
+type Converter interface {
+	Convert(m Money, target Currency) (Money, error)
+}
+
+// MultiMoney holds at most one Money per currency, like a wallet or a cart paid in many currencies.
+// It's a value: every operation returns a new MultiMoney and the zero value is an empty one.
+// Currencies with a zero amount are dropped.
+type MultiMoney struct {
+	amounts map[string]Money
+}
+
+// NewMultiMoney returns a MultiMoney with the sum of the given moneys
+func NewMultiMoney(ms ...Money) (mm MultiMoney, err error) {
+	for _, m := range ms {
+		mm, err = mm.Add(m)
+		if err != nil {
+			return MultiMoney{}, err
+		}
+	}
+
+	return mm, err
+}
+
+func MustNewMultiMoney(ms ...Money) MultiMoney {
+	mm, err := NewMultiMoney(ms...)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm
+}
+
+// Add adds m to the money of its currency
+func (mm MultiMoney) Add(m Money) (MultiMoney, error) {
+	return mm.apply(m, Money.Add)
+}
+
+func (mm MultiMoney) MustAdd(m Money) MultiMoney {
+	s, err := mm.Add(m)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Subtract subtracts m from the money of its currency, the result can be negative
+func (mm MultiMoney) Subtract(m Money) (MultiMoney, error) {
+	return mm.apply(m, Money.Subtract)
+}
+
+func (mm MultiMoney) MustSubtract(m Money) MultiMoney {
+	s, err := mm.Subtract(m)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func (mm MultiMoney) apply(m Money, op func(Money, Money) (Money, error)) (s MultiMoney, err error) {
+	current, ok := mm.amounts[m.Currency.Code]
+	if !ok {
+		current = ForgeWellFormed(0, m.Currency)
+	}
+	result, err := op(current, m)
+	if err != nil {
+		return s, err
+	}
+
+	s.amounts = make(map[string]Money, len(mm.amounts)+1)
+	for code, a := range mm.amounts {
+		s.amounts[code] = a
+	}
+	if result.IsZero() {
+		delete(s.amounts, m.Currency.Code)
+	} else {
+		s.amounts[m.Currency.Code] = result
+	}
+
+	return s, err
+}
+
+// Get returns the money of a currency, false when the currency is not in the MultiMoney
+func (mm MultiMoney) Get(code string) (m Money, ok bool) {
+	m, ok = mm.amounts[strings.ToUpper(code)]
+	return m, ok
+}
+
+// Currencies returns the currency codes sorted alphabetically
+func (mm MultiMoney) Currencies() []string {
+	codes := make([]string, 0, len(mm.amounts))
+	for code := range mm.amounts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Moneys returns all the moneys sorted by currency code
+func (mm MultiMoney) Moneys() []Money {
+	ms := make([]Money, 0, len(mm.amounts))
+	for _, code := range mm.Currencies() {
+		ms = append(ms, mm.amounts[code])
+	}
+
+	return ms
+}
+
+func (mm MultiMoney) IsZero() bool {
+	return len(mm.amounts) == 0
+}
+
+func (mm MultiMoney) IsEquals(cmp MultiMoney) bool {
+	if len(mm.amounts) != len(cmp.amounts) {
+		return false
+	}
+	for code, m := range mm.amounts {
+		if c, ok := cmp.amounts[code]; !ok || !m.IsEquals(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Total converts every money to target with the converter and returns the sum
+func (mm MultiMoney) Total(target Currency, converter Converter) (t Money, err error) {
+	t = ForgeWellFormed(0, target)
+	for _, m := range mm.Moneys() {
+		c, err := converter.Convert(m, target)
+		if err != nil {
+			return Money{}, err
+		}
+		t, err = t.Add(c)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+
+	return t, err
+}
+
+// String returns the moneys sorted by currency code like "EUR 100, USD 250"
+func (mm MultiMoney) String() string {
+	ss := make([]string, 0, len(mm.amounts))
+	for _, m := range mm.Moneys() {
+		ss = append(ss, m.String())
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+// ParseMultiMoney Create a MultiMoney by a string like "EUR 100, USD 250", the same currency can repeat
+func ParseMultiMoney(s string) (mm MultiMoney, err error) {
+	if strings.TrimSpace(s) == "" {
+		return mm, err
+	}
+
+	offset := 0
+	for _, part := range strings.Split(s, ",") {
+		if len(strings.Fields(part)) != 2 {
+			return MultiMoney{}, &ParseError{Input: s, Offset: offset + fieldOffset(part, 0), Reason: "money field should be like `EUR 123`"}
+		}
+		m, err := Parse(part)
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				// locate the failure in s and not in the segment
+				return MultiMoney{}, &ParseError{Input: s, Offset: offset + pe.Offset, Reason: pe.Reason, Err: pe.Err}
+			}
+			return MultiMoney{}, err
+		}
+		offset += len(part) + 1
+		mm, err = mm.Add(m)
+		if err != nil {
+			return MultiMoney{}, err
+		}
+	}
+
+	return mm, err
+}
+
+func (mm MultiMoney) MarshalJSON() ([]byte, error) {
+	dtos := make([]DTO, 0, len(mm.amounts))
+	for _, m := range mm.Moneys() {
+		dtos = append(dtos, m.ExtractDTO())
+	}
+
+	return json.Marshal(dtos)
+}
+
+func (mm *MultiMoney) UnmarshalJSON(data []byte) error {
+	var dtos []DTO
+	if err := json.Unmarshal(data, &dtos); err != nil {
+		return err
+	}
+
+	s := MultiMoney{}
+	for _, dto := range dtos {
+		m, err := dto.ExtractMoney()
+		if err != nil {
+			return err
+		}
+		s, err = s.Add(m)
+		if err != nil {
+			return err
+		}
+	}
+	*mm = s
+
+	return nil
+}
+
+// Scan implements the sql Scanner interface reading a string like "EUR 100, USD 250"
+func (mm *MultiMoney) Scan(value interface{}) error {
+	if value == nil {
+		*mm = MultiMoney{}
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("can't convert given %v", value)
+	}
+
+	parsed, err := ParseMultiMoney(s)
+	if err != nil {
+		return err
+	}
+	*mm = parsed
+
+	return nil
+}
+
+// Value implements the driver Valuer interface, see String
+func (mm MultiMoney) Value() (driver.Value, error) {
+	return mm.String(), nil
+}
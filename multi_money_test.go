@@ -0,0 +1,107 @@
+package money_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/radical-app/money/convert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiMoney_AddSubtract(t *testing.T) {
+	mm, err := money.NewMultiMoney(money.EUR(100), money.USD(250), money.EUR(50))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EUR", "USD"}, mm.Currencies())
+
+	eur, ok := mm.Get("eur")
+	assert.True(t, ok)
+	assert.Equal(t, money.EUR(150), eur)
+
+	less := mm.MustSubtract(money.USD(250)).MustSubtract(money.GBP(10))
+	assert.Equal(t, []string{"EUR", "GBP"}, less.Currencies())
+	gbp, _ := less.Get("GBP")
+	assert.Equal(t, money.GBP(-10), gbp)
+
+	// the original is untouched
+	usd, ok := mm.Get("USD")
+	assert.True(t, ok)
+	assert.Equal(t, money.USD(250), usd)
+
+	_, ok = mm.Get("JPY")
+	assert.False(t, ok)
+
+	_, err = mm.Add(money.EUR(math.MaxInt64))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	assert.True(t, money.MultiMoney{}.IsZero())
+	assert.Equal(t, "EUR 150, USD 250", mm.String())
+}
+
+func TestMultiMoney_Total(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+	usd := money.MustGetCurrencyByISOCode("USD")
+	gbp := money.MustGetCurrencyByISOCode("GBP")
+
+	rates := convert.Rates{
+		convert.ForgeRate(eur, usd, 1.1),
+		convert.ForgeRate(gbp, eur, 1.2),
+	}
+	mm := money.MustNewMultiMoney(money.EUR(100), money.USD(220), money.GBP(100))
+
+	total, err := mm.Total(eur, rates)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(420), total)
+
+	_, err = mm.Total(money.MustGetCurrencyByISOCode("JPY"), rates)
+	var missing *convert.MissingRateError
+	assert.True(t, errors.As(err, &missing))
+}
+
+func TestMultiMoney_JSON(t *testing.T) {
+	mm := money.MustNewMultiMoney(money.USD(250), money.EUR(100))
+
+	got, err := json.Marshal(mm)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"amount":100,"currency":"EUR","symbol":"€","cents":100},{"amount":250,"currency":"USD","symbol":"$","cents":100}]`, string(got))
+
+	cmp := money.MultiMoney{}
+	assert.Nil(t, json.Unmarshal(got, &cmp))
+	assert.True(t, mm.IsEquals(cmp))
+}
+
+func TestMultiMoney_ScanValue(t *testing.T) {
+	mm := money.MustNewMultiMoney(money.USD(250), money.EUR(100))
+
+	v, err := mm.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR 100, USD 250", v)
+
+	scanned := money.MultiMoney{}
+	assert.Nil(t, scanned.Scan([]byte(v.(string))))
+	assert.True(t, mm.IsEquals(scanned))
+
+	assert.Nil(t, scanned.Scan(""))
+	assert.True(t, scanned.IsZero())
+
+	err = scanned.Scan("EUR 100, 200")
+	var parseErr *money.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 9, parseErr.Offset)
+
+	err = scanned.Scan("EUR 100, XYZ 5")
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "EUR 100, XYZ 5", parseErr.Input)
+	assert.Equal(t, 9, parseErr.Offset)
+	assert.Equal(t, "invalid currency", parseErr.Reason)
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{Code: "XYZ"}))
+
+	err = scanned.Scan("EUR 100,USD 1x")
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "EUR 100,USD 1x", parseErr.Input)
+	assert.Equal(t, 12, parseErr.Offset)
+
+	assert.NotNil(t, scanned.Scan(int64(100)))
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"math"
+)
+
+func addInt64(a, b int64) (int64, error) {
+	s := a + b
+	if (b > 0 && s < a) || (b < 0 && s > a) {
+		return 0, ErrOverflow
+	}
+
+	return s, nil
+}
+
+func subInt64(a, b int64) (int64, error) {
+	d := a - b
+	if (b > 0 && d > a) || (b < 0 && d < a) {
+		return 0, ErrOverflow
+	}
+
+	return d, nil
+}
+
+func mulInt64(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, ErrOverflow
+	}
+	p := a * b
+	if p/b != a {
+		return 0, ErrOverflow
+	}
+
+	return p, nil
+}
+
+// floatToInt64 rounds f to the nearest integer failing when it doesn't fit int64
+func floatToInt64(f float64) (int64, error) {
+	r := math.Round(f)
+	// float64(math.MaxInt64) is 2^63 which is already out of range
+	if math.IsNaN(r) || r >= math.MaxInt64 || r < math.MinInt64 {
+		return 0, ErrOverflow
+	}
+
+	return int64(r), nil
+}
@@ -0,0 +1,66 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_AddOverflow(t *testing.T) {
+	_, err := money.EUR(math.MaxInt64).Add(money.EUR(1))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.EUR(math.MinInt64).Add(money.EUR(-1))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	s, err := money.EUR(math.MaxInt64).Add(money.EUR(-1))
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(math.MaxInt64-1), s)
+
+	assert.PanicsWithValue(t, money.ErrOverflow, func() { money.EUR(math.MaxInt64).MustAdd(money.EUR(1)) })
+}
+
+func TestMoney_SubtractOverflow(t *testing.T) {
+	_, err := money.EUR(math.MinInt64).Subtract(money.EUR(1))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.EUR(0).Subtract(money.EUR(math.MinInt64))
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	assert.PanicsWithValue(t, money.ErrOverflow, func() { money.EUR(math.MinInt64).MustSubtract(money.EUR(1)) })
+}
+
+func TestMoney_MultiplyOverflow(t *testing.T) {
+	_, err := money.EUR(math.MaxInt64/2 + 1).Multiply(2)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.EUR(math.MinInt64).Multiply(-1)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.EUR(math.MaxInt64).MultiplyRatio(3, 2, money.RoundHalfEven)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	assert.PanicsWithValue(t, money.ErrOverflow, func() { money.EUR(math.MaxInt64).MustMultiply(2) })
+}
+
+func TestForgeFloatWithCurrencyOverflow(t *testing.T) {
+	eur := money.MustGetCurrencyByISOCode("EUR")
+
+	_, err := money.ForgeFloatWithCurrency(1e17, eur)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.ForgeFloatWithCurrency(math.Inf(-1), eur)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	_, err = money.ForgeFloat(1e17, "EUR")
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	m, err := money.ForgeFloatWithCurrency(1e15, eur)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(1e17), m)
+
+	assert.PanicsWithValue(t, money.ErrOverflow, func() { money.FloatIRR(1e17) })
+}
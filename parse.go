@@ -1,52 +1,81 @@
 package money
 
 import (
-	"errors"
-	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
+// ParseWithFallback Create a money object by a string like "EUR 123", fallbackCurr is used for "123"
 func ParseWithFallback(s string, fallbackCurr Currency) (m Money, err error) {
+	return parseWithFallback(s, fallbackCurr, CurrencyByISOCode)
+}
+
+func parseWithFallback(s string, fallbackCurr Currency, lookup func(string) (Currency, error)) (m Money, err error) {
 	m = EUR(0)
 	code := DefaultCurrencyCode
 	curr := fallbackCurr
-	if !fallbackCurr.IsValid() {
-		curr, err = CurrencyByISOCode(code)
-		if err != nil {
-			return m, err
-		}
+	// an isolated registry may not have the default currency, it's an error only when s has no code
+	var fallbackErr error
+	if _, err := lookup(fallbackCurr.Code); fallbackCurr.Code == "" || err != nil {
+		curr, fallbackErr = lookup(code)
 	}
-	m = ForgeWithCurrency(0, curr)
+	m = ForgeWellFormed(0, curr)
 
 	if s == "" {
-		return m, errors.New("empty string")
+		return m, &ParseError{Input: s, Reason: "empty string"}
 	}
 
 	ss := strings.Fields(s)
 	if len(ss) != 1 && len(ss) != 2 {
-		return m, fmt.Errorf("money field should be like `EUR 123` given %v", s)
+		offset := fieldOffset(s, 0)
+		if len(ss) > 2 {
+			offset = fieldOffset(s, 2)
+		}
+		return m, &ParseError{Input: s, Offset: offset, Reason: "money field should be like `EUR 123`"}
 	}
 
 	amountAsInt, err := strconv.ParseInt(ss[len(ss)-1], 10, 64)
 	if err != nil {
-		return m, err
+		return m, &ParseError{Input: s, Offset: fieldOffset(s, len(ss)-1), Reason: "invalid amount", Err: err}
 	}
 
 	// the first group is the curr code iso code
 	// use default Currency
 	if len(ss) == 2 {
 		code = ss[0]
-		curr, err = CurrencyByISOCode(code)
+		curr, err = lookup(code)
 		if err != nil {
-			return m, err
+			return m, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "invalid currency", Err: err}
 		}
+	} else if fallbackErr != nil {
+		return m, fallbackErr
 	}
 
-	return ForgeWithCurrency(amountAsInt, curr), err
+	return ForgeWellFormed(amountAsInt, curr), err
 }
 
 // Parse Create a money object by a string like "EUR 123" "CurrencyCode Int64" or "Int64"
 func Parse(s string) (m Money, err error) {
 	return ParseWithFallback(s, Currency{})
 }
+
+// fieldOffset returns the byte offset of the i-th space separated field of s
+func fieldOffset(s string, i int) int {
+	inField := false
+	for offset, r := range s {
+		if unicode.IsSpace(r) {
+			inField = false
+			continue
+		}
+		if !inField {
+			if i == 0 {
+				return offset
+			}
+			i--
+			inField = true
+		}
+	}
+
+	return len(s)
+}
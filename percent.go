@@ -0,0 +1,147 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// PercentScale is the count of Percent units in 1%, a Percent holds hundredths of basis point
+// so rates like 9.975% are exact
+const PercentScale = 10000
+
+// percentDecimals is the count of decimals of a percentage a Percent can hold
+const percentDecimals = 4
+
+// Percent is a percentage in hundredths of basis point, 12.5% is 125000 and 100% is 1000000
+type Percent int64
+
+// ForgePercent returns the Percent of an integer percentage, 20 is 20%.
+// It fails with ErrOverflow when the percentage doesn't fit a Percent
+func ForgePercent(percent int64) (p Percent, err error) {
+	v, err := mulInt64(percent, PercentScale)
+	if err != nil {
+		return p, err
+	}
+
+	return Percent(v), err
+}
+
+func MustForgePercent(percent int64) Percent {
+	p, err := ForgePercent(percent)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// BasisPoints returns the Percent of bp basis points, 1 basis point is 0.01%.
+// It fails with ErrOverflow when the basis points don't fit a Percent
+func BasisPoints(bp int64) (p Percent, err error) {
+	v, err := mulInt64(bp, PercentScale/100)
+	if err != nil {
+		return p, err
+	}
+
+	return Percent(v), err
+}
+
+func MustBasisPoints(bp int64) Percent {
+	p, err := BasisPoints(bp)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// ParsePercent Create a Percent by a string like "12.5%", the % sign is optional
+// and at most 4 decimals are allowed
+func ParsePercent(s string) (p Percent, err error) {
+	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	if trimmed == "" {
+		return p, &ParseError{Input: s, Offset: 0, Reason: "percent field should be like `12.5%`"}
+	}
+
+	a, err := parseDecimal(trimmed, percentDecimals)
+	if err != nil {
+		return p, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "invalid percent", Err: err}
+	}
+	if !a.IsInt64() {
+		return p, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "invalid percent", Err: ErrOverflow}
+	}
+
+	return Percent(a.Int64()), err
+}
+
+func MustParsePercent(s string) Percent {
+	p, err := ParsePercent(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Rat returns the percent as exact fraction, 12.5% is 1/8
+func (p Percent) Rat() *big.Rat {
+	return big.NewRat(int64(p), 100*PercentScale)
+}
+
+// Float returns the percentage as float, 12.5% is 12.5
+func (p Percent) Float() float64 {
+	return float64(p) / PercentScale
+}
+
+// String returns the percentage without trailing zeros like "12.5%"
+func (p Percent) String() string {
+	s := formatMinorUnits(big.NewInt(int64(p)), percentDecimals)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s + "%"
+}
+
+// ApplyPercent returns p of the money, rounded to the minor unit with mode.
+// The computation is done on the integer amount only, the float value is never used
+func (m Money) ApplyPercent(p Percent, mode RoundingMode) (Money, error) {
+	return m.MultiplyRatio(int64(p), 100*PercentScale, mode)
+}
+
+func (m Money) MustApplyPercent(p Percent, mode RoundingMode) Money {
+	s, err := m.ApplyPercent(p, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// PercentOf returns how much of total the money is, rounded half even to the hundredth of basis point
+func (m Money) PercentOf(total Money) (p Percent, err error) {
+	if !m.Currency.IsEquals(total.Currency) {
+		return p, &CurrencyMismatchError{Left: m.Currency, Right: total.Currency}
+	}
+	if total.IsZero() {
+		return p, errors.New("can't compute the percent of a zero total")
+	}
+
+	n := new(big.Int).Mul(big.NewInt(m.Amount.Int64()), big.NewInt(100*PercentScale))
+	q := quoRound(n, big.NewInt(total.Amount.Int64()), RoundHalfEven)
+	if !q.IsInt64() {
+		return p, ErrOverflow
+	}
+
+	return Percent(q.Int64()), err
+}
+
+func (m Money) MustPercentOf(total Money) Percent {
+	p, err := m.PercentOf(total)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
@@ -0,0 +1,109 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		in   string
+		want money.Percent
+	}{
+		{"12.5%", 125000},
+		{"12.5", 125000},
+		{" 9.975 % ", 99750},
+		{"100%", 1000000},
+		{"-0.01%", -100},
+		{"0.0001%", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := money.ParsePercent(tt.in)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, in := range []string{"", "%", "abc%", "1.00001%", "12.5.1%"} {
+		_, err := money.ParsePercent(in)
+		var pe *money.ParseError
+		assert.True(t, errors.As(err, &pe), in)
+	}
+}
+
+func TestPercent_String(t *testing.T) {
+	assert.Equal(t, "12.5%", money.MustParsePercent("12.5%").String())
+	assert.Equal(t, "20%", money.MustForgePercent(20).String())
+	assert.Equal(t, "0.25%", money.MustBasisPoints(25).String())
+	assert.Equal(t, "-9.975%", money.MustParsePercent("-9.975%").String())
+	assert.Equal(t, 12.5, money.MustParsePercent("12.5%").Float())
+	assert.Equal(t, "1/8", money.MustParsePercent("12.5%").Rat().String())
+}
+
+func TestForgePercent_overflow(t *testing.T) {
+	_, err := money.ForgePercent(math.MaxInt64 / 1000)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	_, err = money.BasisPoints(math.MinInt64 / 10)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	assert.Panics(t, func() { money.MustForgePercent(math.MaxInt64) })
+
+	assert.Panics(t, func() { money.EUR(100).PercentOff(math.MaxInt64) })
+	assert.Panics(t, func() { money.EUR(100).PercentOffFloat(1e300) })
+	assert.Panics(t, func() { money.EUR(100).PercentOffFloat(math.NaN()) })
+	assert.Panics(t, func() { money.EUR(math.MaxInt64).PercentOff(200) })
+
+	_, err = money.EUR(math.MaxInt64).TryPercentOff(200)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	_, err = money.EUR(100).TryPercentOff(math.MaxInt64)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	_, err = money.EUR(100).TryPercentOffFloat(math.NaN())
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+	_, err = money.EUR(math.MaxInt64).TryPercentOffFloat(200)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+
+	got, err := money.EUR(1000).TryPercentOff(20)
+	assert.NoError(t, err)
+	assert.Equal(t, money.EUR(200), got)
+	got, err = money.EUR(1000).TryPercentOffFloat(20.5)
+	assert.NoError(t, err)
+	assert.Equal(t, money.EUR(205), got)
+}
+
+func TestMoney_ApplyPercent(t *testing.T) {
+	p := money.MustParsePercent("12.5%")
+
+	assert.Equal(t, money.EUR(125), money.EUR(1000).MustApplyPercent(p, money.RoundHalfEven))
+	// 1001 * 12.5% = 125.125
+	assert.Equal(t, money.EUR(125), money.EUR(1001).MustApplyPercent(p, money.RoundHalfEven))
+	assert.Equal(t, money.EUR(126), money.EUR(1001).MustApplyPercent(p, money.RoundUp))
+
+	// exact where the float computation is not
+	large := money.EUR(math.MaxInt64 / 2)
+	got, err := large.ApplyPercent(money.MustForgePercent(200), money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(math.MaxInt64-1), got)
+
+	_, err = money.EUR(math.MaxInt64).ApplyPercent(money.MustForgePercent(200), money.RoundHalfEven)
+	assert.True(t, errors.Is(err, money.ErrOverflow))
+}
+
+func TestMoney_PercentOf(t *testing.T) {
+	p, err := money.EUR(125).PercentOf(money.EUR(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, money.MustParsePercent("12.5%"), p)
+
+	p, err = money.EUR(1).PercentOf(money.EUR(3))
+	assert.Nil(t, err)
+	assert.Equal(t, "33.3333%", p.String())
+
+	_, err = money.EUR(1).PercentOf(money.EUR(0))
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(1).PercentOf(money.USD(3))
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
@@ -0,0 +1,264 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Price is an amount with Scale more decimals than the currency minor unit, e.g. a per unit price
+// like EUR 0.00035. Prices are multiplied and summed exactly, then rounded to Money with ToMoney
+// only when the final amount is needed.
+// Amount is expressed in 10^-(Currency.MinorUnit+Scale) units, so EUR 0.00035 with Scale 6 is 35000.
+type Price struct {
+	Amount   *big.Int
+	Scale    int
+	Currency Currency
+}
+
+// ForgePrice
+// amount   int64  The amount in 10^-(minor unit + scale) units
+// scale    int    The count of extra decimals after the currency minor unit
+// currCode string Three-letter ISO currency code
+// it fails with *WithdrawnCurrencyError for a withdrawn currency after RefuseWithdrawnCurrencies(true)
+func ForgePrice(amount int64, scale int, currCode string) (p Price, err error) {
+	c, err := defaultRegistry.forgeCurrency(currCode)
+	if err != nil {
+		return p, err
+	}
+
+	return ForgePriceWithCurrency(big.NewInt(amount), scale, c)
+}
+
+func MustForgePrice(amount int64, scale int, currCode string) Price {
+	p, err := ForgePrice(amount, scale, currCode)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// ForgePriceWithCurrency
+// amount   *big.Int The amount in 10^-(minor unit + scale) units, it's copied
+// scale    int      The count of extra decimals after the currency minor unit
+// currency Currency The currency Value Object
+func ForgePriceWithCurrency(amount *big.Int, scale int, c Currency) (p Price, err error) {
+	if scale < 0 {
+		return p, errors.New("price scale can't be negative")
+	}
+	m := ForgeBigWithCurrency(amount, c)
+
+	return Price{Amount: m.Amount, Scale: scale, Currency: m.Currency}, err
+}
+
+// ParsePrice Create a price by a string like "EUR 0.00035" with scale extra decimals after the minor unit,
+// the string can't have more decimals than the price can hold. Prices are new amounts so a withdrawn currency
+// is refused like ForgePrice does
+func ParsePrice(s string, scale int) (p Price, err error) {
+	ss := strings.Fields(s)
+	if len(ss) != 2 {
+		return p, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "price field should be like `EUR 0.00035`"}
+	}
+
+	c, err := defaultRegistry.forgeCurrency(ss[0])
+	if err != nil {
+		return p, &ParseError{Input: s, Offset: fieldOffset(s, 0), Reason: "invalid currency", Err: err}
+	}
+
+	amount, err := parseDecimal(ss[1], c.MinorUnit+scale)
+	if err != nil {
+		return p, &ParseError{Input: s, Offset: fieldOffset(s, 1), Reason: "invalid amount", Err: err}
+	}
+
+	return ForgePriceWithCurrency(amount, scale, c)
+}
+
+func MustParsePrice(s string, scale int) Price {
+	p, err := ParsePrice(s, scale)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// ToPrice returns the money as a price with scale extra decimals
+func (m Money) ToPrice(scale int) (p Price, err error) {
+	if scale < 0 {
+		return p, errors.New("price scale can't be negative")
+	}
+	a := new(big.Int).Mul(big.NewInt(m.Amount.Int64()), pow10(scale))
+
+	return Price{Amount: a, Scale: scale, Currency: m.Currency}, err
+}
+
+// Rescale returns the money as a price with minorUnit decimals, e.g. 4 for an internal EUR balance
+// or 2 for an ISK amount stored before ISO changed its exponent to 0.
+// Going to more decimals is exact, going to less decimals than the currency rounds with mode
+// and the price keeps the currency precision, EUR 12.35 with 1 decimal is EUR 12.40 with RoundHalfEven
+func (m Money) Rescale(minorUnit int, mode RoundingMode) (p Price, err error) {
+	if minorUnit < 0 {
+		return p, errors.New("minor unit can't be negative")
+	}
+	if minorUnit >= m.Currency.MinorUnit {
+		return m.ToPrice(minorUnit - m.Currency.MinorUnit)
+	}
+
+	step := pow10(m.Currency.MinorUnit - minorUnit)
+	a := quoRound(big.NewInt(m.Amount.Int64()), step, mode)
+
+	return Price{Amount: a.Mul(a, step), Scale: 0, Currency: m.Currency}, err
+}
+
+func (m Money) MustRescale(minorUnit int, mode RoundingMode) Price {
+	p, err := m.Rescale(minorUnit, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Rescale returns the price with scale extra decimals, going to less decimals rounds with mode
+func (p Price) Rescale(scale int, mode RoundingMode) (r Price, err error) {
+	if scale < 0 {
+		return r, errors.New("price scale can't be negative")
+	}
+	if scale >= p.Scale {
+		return Price{Amount: p.upscale(scale), Scale: scale, Currency: p.Currency}, err
+	}
+
+	return Price{Amount: quoRound(p.amount(), pow10(p.Scale-scale), mode), Scale: scale, Currency: p.Currency}, err
+}
+
+func (p Price) MustRescale(scale int, mode RoundingMode) Price {
+	r, err := p.Rescale(scale, mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+func (p Price) amount() *big.Int {
+	if p.Amount == nil {
+		return new(big.Int)
+	}
+
+	return p.Amount
+}
+
+// Multiply returns the price multiplied by a quantity, the result is exact
+func (p Price) Multiply(quantity int64) Price {
+	return Price{Amount: new(big.Int).Mul(p.amount(), big.NewInt(quantity)), Scale: p.Scale, Currency: p.Currency}
+}
+
+// Add returns the exact sum of two prices with the same currency, the result has the biggest scale of the two
+func (p Price) Add(addendum Price) (s Price, err error) {
+	if !p.Currency.IsEquals(addendum.Currency) {
+		return s, &CurrencyMismatchError{Left: p.Currency, Right: addendum.Currency}
+	}
+
+	scale := p.Scale
+	if addendum.Scale > scale {
+		scale = addendum.Scale
+	}
+	a := new(big.Int).Add(p.upscale(scale), addendum.upscale(scale))
+
+	return Price{Amount: a, Scale: scale, Currency: p.Currency}, err
+}
+
+func (p Price) MustAdd(addendum Price) (s Price) {
+	s, err := p.Add(addendum)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// upscale returns the amount expressed with a scale bigger or equal to the price one
+func (p Price) upscale(scale int) *big.Int {
+	return new(big.Int).Mul(p.amount(), pow10(scale-p.Scale))
+}
+
+func (p Price) IsZero() bool {
+	return p.amount().Sign() == 0
+}
+
+// IsEquals compares the value, the same price with different scale is equal
+func (p Price) IsEquals(cmp Price) bool {
+	if !p.Currency.IsEquals(cmp.Currency) {
+		return false
+	}
+	scale := p.Scale
+	if cmp.Scale > scale {
+		scale = cmp.Scale
+	}
+
+	return p.upscale(scale).Cmp(cmp.upscale(scale)) == 0
+}
+
+// ToMoney rounds the price to the currency minor unit with mode,
+// it fails with ErrOverflow when the amount doesn't fit int64
+func (p Price) ToMoney(mode RoundingMode) (m Money, err error) {
+	return p.ToBigMoney(mode).ToMoney()
+}
+
+func (p Price) MustToMoney(mode RoundingMode) Money {
+	m, err := p.ToMoney(mode)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// ToBigMoney rounds the price to the currency minor unit with mode
+func (p Price) ToBigMoney(mode RoundingMode) BigMoney {
+	a := quoRound(p.amount(), pow10(p.Scale), mode)
+
+	return BigMoney{Amount: a, Currency: p.Currency}
+}
+
+// AmountAsString returns the exact amount in units like "0.00035000"
+func (p Price) AmountAsString() string {
+	return formatMinorUnits(p.amount(), p.Currency.MinorUnit+p.Scale)
+}
+
+func (p Price) String() string {
+	return fmt.Sprintf("%s %s", p.Currency.String(), p.AmountAsString())
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// parseDecimal parses a decimal string like "-12.345" as an integer with the given count of decimals
+func parseDecimal(s string, decimals int) (*big.Int, error) {
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if len(fracPart) > decimals {
+		return nil, fmt.Errorf("too many decimals, at most %d", decimals)
+	}
+	if intPart == "" || intPart == "-" || intPart == "+" {
+		intPart += "0"
+	}
+	for _, r := range fracPart {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid decimal %s", s)
+		}
+	}
+
+	digits := intPart + fracPart + strings.Repeat("0", decimals-len(fracPart))
+	a, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %s", s)
+	}
+
+	return a, nil
+}
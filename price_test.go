@@ -0,0 +1,135 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrice(t *testing.T) {
+	p, err := money.ParsePrice("EUR 0.00035", 6)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(35000), p.Amount.Int64())
+	assert.Equal(t, "EUR 0.00035000", p.String())
+
+	p, err = money.ParsePrice("JPY -1.5", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-150), p.Amount.Int64())
+
+	_, err = money.ParsePrice("EUR 0.000000001", 6)
+	var parseErr *money.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 4, parseErr.Offset)
+
+	_, err = money.ParsePrice("XYZ 1", 6)
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{}))
+
+	_, err = money.ParsePrice("EUR 1", -1)
+	assert.NotNil(t, err)
+}
+
+func TestPrice_ToMoney(t *testing.T) {
+	perCall := money.MustParsePrice("EUR 0.00035", 6)
+
+	// 12345 calls = EUR 4.32075
+	total := perCall.Multiply(12345)
+	assert.Equal(t, "EUR 4.32075000", total.String())
+
+	tests := []struct {
+		mode money.RoundingMode
+		want money.Money
+	}{
+		{money.RoundHalfEven, money.EUR(432)},
+		{money.RoundUp, money.EUR(433)},
+		{money.RoundDown, money.EUR(432)},
+	}
+	for _, tt := range tests {
+		got, err := total.ToMoney(tt.mode)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got, tt.mode.String())
+	}
+}
+
+func TestPrice_Add(t *testing.T) {
+	a := money.MustParsePrice("EUR 0.00035", 6)
+	b := money.MustParsePrice("EUR 0.001", 2)
+
+	s, err := a.Add(b)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, s.Scale)
+	assert.Equal(t, "EUR 0.00135000", s.String())
+
+	// accumulating many tiny prices doesn't lose anything
+	acc, err := money.EUR(0).ToPrice(6)
+	assert.Nil(t, err)
+	for i := 0; i < 1000; i++ {
+		acc = acc.MustAdd(a)
+	}
+	assert.Equal(t, money.EUR(35), acc.MustToMoney(money.RoundHalfEven))
+
+	_, err = a.Add(money.MustParsePrice("USD 1", 6))
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
+
+func TestMoney_ToPrice(t *testing.T) {
+	p, err := money.EUR(199).ToPrice(4)
+	assert.Nil(t, err)
+	assert.True(t, p.IsEquals(money.MustParsePrice("EUR 1.99", 0)))
+	assert.Equal(t, money.EUR(199), p.MustToMoney(money.RoundHalfEven))
+}
+
+func TestMoney_Rescale(t *testing.T) {
+	p, err := money.EUR(1234).Rescale(4, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(123400), p.Amount.Int64())
+	assert.Equal(t, 2, p.Scale)
+	assert.Equal(t, "EUR 12.3400", p.String())
+
+	p, err = money.EUR(1235).Rescale(1, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR 12.40", p.String())
+	assert.Equal(t, money.EUR(1240), p.MustToMoney(money.RoundHalfEven))
+
+	p, err = money.EUR(math.MaxInt64).Rescale(3, money.RoundHalfEven)
+	assert.Nil(t, err)
+	_, err = p.ToMoney(money.RoundHalfEven)
+	assert.Nil(t, err, "back to the currency precision it fits again")
+
+	_, err = money.EUR(1).Rescale(-1, money.RoundHalfEven)
+	assert.NotNil(t, err)
+}
+
+func TestPrice_Rescale(t *testing.T) {
+	balance := money.MustForgePrice(123456, 2, "EUR") // EUR 12.3456
+
+	tests := []struct {
+		mode money.RoundingMode
+		want money.Money
+	}{
+		{money.RoundHalfEven, money.EUR(1235)},
+		{money.RoundDown, money.EUR(1234)},
+		{money.RoundCeiling, money.EUR(1235)},
+	}
+	for _, tt := range tests {
+		got, err := balance.Rescale(0, tt.mode)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got.MustToMoney(tt.mode), tt.mode.String())
+	}
+
+	up := balance.MustRescale(4, money.RoundHalfEven)
+	assert.Equal(t, "EUR 12.345600", up.String())
+	assert.True(t, up.IsEquals(balance))
+
+	_, err := balance.Rescale(-1, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	// ISK had 2 decimals before 2007
+	oldISK := money.MustForgePrice(12350, 2, "ISK")
+	assert.Equal(t, money.ISK(124), oldISK.MustToMoney(money.RoundHalfUp))
+
+	back := money.EUR(1234).MustRescale(4, money.RoundHalfEven).MustToMoney(money.RoundHalfEven)
+	assert.Equal(t, money.EUR(1234), back)
+}
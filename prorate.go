@@ -0,0 +1,87 @@
+package money
+
+import (
+	"errors"
+	"time"
+)
+
+// ProrationUnit is the granularity used to measure the time when prorating
+type ProrationUnit int
+
+const (
+	// ProrateByDay counts calendar days, the time of the day is ignored
+	ProrateByDay ProrationUnit = iota
+	// ProrateBySecond counts elapsed seconds
+	ProrateBySecond
+)
+
+// Prorate returns the part of m for the days from-to of the period periodStart-periodEnd, rounded with mode
+func Prorate(m Money, periodStart, periodEnd, from, to time.Time, mode RoundingMode) (Money, error) {
+	return ProrateBy(ProrateByDay, m, periodStart, periodEnd, from, to, mode)
+}
+
+// ProrateBy returns the part of m for the time from-to of the period periodStart-periodEnd measured by unit, rounded with mode
+func ProrateBy(unit ProrationUnit, m Money, periodStart, periodEnd, from, to time.Time, mode RoundingMode) (p Money, err error) {
+	if unit == ProrateByDay {
+		// the bounds are checked on the same calendar dates used to prorate
+		periodStart, periodEnd, from, to = date(periodStart), date(periodEnd), date(from), date(to)
+	}
+	if periodEnd.Before(periodStart) || to.Before(from) {
+		return p, errors.New("the end of a range can't be before its start")
+	}
+	if from.Before(periodStart) || to.After(periodEnd) {
+		return p, errors.New("the prorated range must be inside the period")
+	}
+
+	period := elapsed(unit, periodStart, periodEnd)
+	if period == 0 {
+		return p, errors.New("can't prorate on an empty period")
+	}
+
+	return m.MultiplyRatio(elapsed(unit, from, to), period, mode)
+}
+
+func elapsed(unit ProrationUnit, from, to time.Time) int64 {
+	if unit == ProrateBySecond {
+		return int64(to.Sub(from) / time.Second)
+	}
+
+	return int64(date(to).Sub(date(from)) / (24 * time.Hour))
+}
+
+// date returns the calendar date of t in its location as midnight UTC
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// PlanChange is the result of switching plan in the middle of a period, Net is Charge minus Credit
+type PlanChange struct {
+	Credit Money `json:"credit"`
+	Charge Money `json:"charge"`
+	Net    Money `json:"net"`
+}
+
+// ProrateChange computes the credit for the unused part of the old plan and the charge for the rest of the
+// period on the new plan, both with the same fraction of the period from changeAt to periodEnd
+func ProrateChange(oldPlan, newPlan Money, periodStart, periodEnd, changeAt time.Time, unit ProrationUnit, mode RoundingMode) (c PlanChange, err error) {
+	if !oldPlan.Currency.IsEquals(newPlan.Currency) {
+		return c, &CurrencyMismatchError{Left: oldPlan.Currency, Right: newPlan.Currency}
+	}
+
+	c.Credit, err = ProrateBy(unit, oldPlan, periodStart, periodEnd, changeAt, periodEnd, mode)
+	if err != nil {
+		return PlanChange{}, err
+	}
+	c.Charge, err = ProrateBy(unit, newPlan, periodStart, periodEnd, changeAt, periodEnd, mode)
+	if err != nil {
+		return PlanChange{}, err
+	}
+	c.Net, err = c.Charge.Subtract(c.Credit)
+	if err != nil {
+		return PlanChange{}, err
+	}
+
+	return c, err
+}
@@ -0,0 +1,63 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProrate(t *testing.T) {
+	start := time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	// 10 days out of 30
+	p, err := money.Prorate(money.EUR(3000), start, end, time.Date(2020, time.April, 21, 15, 30, 0, 0, time.UTC), end, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(1000), p)
+
+	// 7 days out of 30
+	p, err = money.Prorate(money.EUR(1000), start, end, start, time.Date(2020, time.April, 8, 0, 0, 0, 0, time.UTC), money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(233), p)
+
+	// 12 hours out of 30 days
+	p, err = money.ProrateBy(money.ProrateBySecond, money.EUR(3000), start, end, start, start.Add(12*time.Hour), money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(50), p)
+
+	_, err = money.Prorate(money.EUR(3000), start, end, start.Add(-time.Hour*48), end, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	// by day the bounds are checked on dates, a from earlier on the first day of the period is inside it
+	opensAt := start.Add(10 * time.Hour)
+	p, err = money.Prorate(money.EUR(3000), opensAt, end, start.Add(8*time.Hour), end, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(3000), p)
+
+	_, err = money.ProrateBy(money.ProrateBySecond, money.EUR(3000), opensAt, end, start.Add(8*time.Hour), end, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	_, err = money.Prorate(money.EUR(3000), start, end, end, start, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	_, err = money.Prorate(money.EUR(3000), start, start, start, start, money.RoundHalfEven)
+	assert.NotNil(t, err)
+}
+
+func TestProrateChange(t *testing.T) {
+	start := time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)
+	changeAt := time.Date(2020, time.April, 11, 9, 0, 0, 0, time.UTC)
+
+	c, err := money.ProrateChange(money.EUR(999), money.EUR(2999), start, end, changeAt, money.ProrateByDay, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(666), c.Credit)
+	assert.Equal(t, money.EUR(1999), c.Charge)
+	assert.Equal(t, money.EUR(1333), c.Net)
+
+	_, err = money.ProrateChange(money.EUR(999), money.USD(2999), start, end, changeAt, money.ProrateByDay, money.RoundHalfEven)
+	assert.True(t, errors.Is(err, &money.CurrencyMismatchError{}))
+}
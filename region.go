@@ -0,0 +1,85 @@
+package money
+
+import (
+	"errors"
+	"sort"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+type regionOptions struct {
+	funds bool
+}
+
+// RegionOption customizes CurrenciesForRegion
+type RegionOption func(*regionOptions)
+
+// WithFundCodes adds the fund codes that aren't legal tender, like CHE and CHW for CH
+func WithFundCodes() RegionOption {
+	return func(o *regionOptions) { o.funds = true }
+}
+
+// CurrenciesForRegion returns the currencies used today in an ISO 3166 region like "CH", the main legal tender first.
+// Today is the clock of the default registry, see SetClock. The region data comes from CLDR, the withdrawn currencies are
+// replaced by their successor and the ones missing from the default registry are skipped
+func CurrenciesForRegion(region string, opts ...RegionOption) (cs []Currency, err error) {
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	o := regionOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	query := []currency.QueryOption{currency.Region(r)}
+	if o.funds {
+		query = append(query, currency.NonTender)
+	}
+	seen := map[string]bool{}
+	for it := currency.Query(query...); it.Next(); {
+		c, err := Successor(it.Unit().String(), defaultRegistry.today())
+		if err == nil && !seen[c.Code] {
+			seen[c.Code] = true
+			cs = append(cs, c)
+		}
+	}
+
+	return cs, nil
+}
+
+// RegionsForCurrency returns the ISO 3166 regions where the currency is legal tender today sorted alphabetically,
+// e.g. "AT", "BE", ... for EUR
+func RegionsForCurrency(code string) (regions []string, err error) {
+	c, err := CurrencyByISOCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	for it := currency.Query(); it.Next(); {
+		if s, err := Successor(it.Unit().String(), defaultRegistry.today()); err == nil && s.Code == c.Code {
+			regions = append(regions, it.Region().String())
+		}
+	}
+	sort.Strings(regions)
+
+	return regions, nil
+}
+
+// DefaultCurrencyForLocale returns the currency of the region of a locale like "de-AT",
+// the region is guessed when missing so "de" is EUR. It fails when no currency can be guessed,
+// the caller may then fall back to DefaultCurrencyCode
+func DefaultCurrencyForLocale(locale string) (c Currency, err error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return c, err
+	}
+
+	unit, confidence := currency.FromTag(tag)
+	if confidence == language.No {
+		return c, errors.New("no currency for locale " + locale)
+	}
+
+	return Successor(unit.String(), defaultRegistry.today())
+}
@@ -0,0 +1,79 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func codes(cs []money.Currency) []string {
+	ss := make([]string, len(cs))
+	for i, c := range cs {
+		ss[i] = c.Code
+	}
+
+	return ss
+}
+
+func TestCurrenciesForRegion(t *testing.T) {
+	cs, err := money.CurrenciesForRegion("CH")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CHF"}, codes(cs))
+
+	cs, err = money.CurrenciesForRegion("ch", money.WithFundCodes())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CHF", "CHE", "CHW"}, codes(cs))
+
+	cs, err = money.CurrenciesForRegion("PA")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"PAB", "USD"}, codes(cs))
+
+	cs, err = money.CurrenciesForRegion("AT")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EUR"}, codes(cs))
+
+	_, err = money.CurrenciesForRegion("not a region")
+	assert.NotNil(t, err)
+}
+
+func TestRegionsForCurrency(t *testing.T) {
+	regions, err := money.RegionsForCurrency("EUR")
+	assert.Nil(t, err)
+	assert.Contains(t, regions, "AT")
+	assert.Contains(t, regions, "IT")
+	assert.NotContains(t, regions, "CH")
+
+	regions, err = money.RegionsForCurrency("CHF")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CH", "LI"}, regions)
+
+	_, err = money.RegionsForCurrency("XYZ")
+	assert.NotNil(t, err)
+}
+
+func TestDefaultCurrencyForLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"de-AT", "EUR"},
+		{"de-CH", "CHF"},
+		{"de", "EUR"},
+		{"fr-CH", "CHF"},
+		{"pt-BR", "BRL"},
+		{"en-GB", "GBP"},
+		{"en", "USD"},
+		{"ja", "JPY"},
+	}
+	for _, tt := range tests {
+		c, err := money.DefaultCurrencyForLocale(tt.locale)
+		assert.Nil(t, err, tt.locale)
+		assert.Equal(t, tt.want, c.Code, tt.locale)
+	}
+
+	_, err := money.DefaultCurrencyForLocale("es-419")
+	assert.NotNil(t, err)
+	_, err = money.DefaultCurrencyForLocale("not a locale")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,293 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Registry is a set of currencies looked up by code, it's safe for concurrent use.
+// The package functions like Forge, Parse and CurrencyByISOCode use a default registry holding the
+// ISO 4217 currencies, an isolated Registry lets non ISO units like loyalty points live next to it
+// without being visible to the rest of the program.
+type Registry struct {
+	mu         sync.RWMutex
+	currencies map[string]Currency
+	// numeric maps the numeric codes to the alpha ones
+	numeric map[string]string
+	// replacements maps the replaced codes to their replacement
+	replacements    map[string]Replacement
+	refuseWithdrawn bool
+	// now is the clock telling the withdrawn currencies, nil is time.Now
+	now func() time.Time
+}
+
+var defaultRegistry = NewISORegistry()
+
+// NewRegistry returns a registry with only the given currencies
+func NewRegistry(cs ...Currency) (*Registry, error) {
+	r := &Registry{currencies: make(map[string]Currency, len(cs)), numeric: map[string]string{}}
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func MustNewRegistry(cs ...Currency) *Registry {
+	r, err := NewRegistry(cs...)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// NewISORegistry returns a registry with all the ISO 4217 currencies
+func NewISORegistry() *Registry {
+	r := &Registry{currencies: make(map[string]Currency, len(currencies)), numeric: map[string]string{}}
+	for code, c := range currencies {
+		r.currencies[code] = c
+		if c.NumericCode != "" {
+			r.numeric[c.NumericCode] = code
+		}
+	}
+	r.replacements = make(map[string]Replacement, len(replacements))
+	for _, rep := range replacements {
+		r.replacements[rep.Old] = rep
+	}
+
+	return r
+}
+
+// RegisterCurrency adds a currency to the default registry, see Registry.Register
+func RegisterCurrency(c Currency) error {
+	return defaultRegistry.Register(c)
+}
+
+// UnregisterCurrency removes a currency from the default registry, see Registry.Unregister.
+// DefaultCurrencyCode is the fallback of Forge and Parse and shouldn't be removed
+func UnregisterCurrency(code string) error {
+	return defaultRegistry.Unregister(code)
+}
+
+// Register adds a currency, the code is stored uppercase.
+// It fails when the code or the numeric code is invalid or already registered or the minor unit is out of range
+func (r *Registry) Register(c Currency) error {
+	c.Code = strings.ToUpper(strings.TrimSpace(c.Code))
+	if err := c.wellFormed(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.currencies == nil {
+		r.currencies, r.numeric = map[string]Currency{}, map[string]string{}
+	}
+	if _, ok := r.currencies[c.Code]; ok {
+		return fmt.Errorf("currency %s is already registered", c.Code)
+	}
+	if code, ok := r.numeric[c.NumericCode]; ok {
+		return fmt.Errorf("numeric code %s is already registered for %s", c.NumericCode, code)
+	}
+	r.currencies[c.Code] = c
+	if c.NumericCode != "" {
+		r.numeric[c.NumericCode] = c.Code
+	}
+
+	return nil
+}
+
+// Unregister removes a currency and the replacements from and to it, the moneys already forged with it keep working
+func (r *Registry) Unregister(code string) error {
+	code = strings.ToUpper(code)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.currencies[code]
+	if !ok {
+		return &UnknownCurrencyError{Code: code}
+	}
+	delete(r.currencies, code)
+	delete(r.numeric, c.NumericCode)
+	for old, rep := range r.replacements {
+		if old == code || rep.New == code {
+			delete(r.replacements, old)
+		}
+	}
+
+	return nil
+}
+
+// CurrencyByCode gets the currency object by alpha code like "EUR" or numeric code like "978"
+func (r *Registry) CurrencyByCode(code string) (currency Currency, err error) {
+	code = strings.ToUpper(code)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	alpha := code
+	if isNumericCode(code) {
+		alpha = r.numeric[code]
+	}
+	if c, ok := r.currencies[alpha]; ok {
+		return c, nil
+	}
+
+	return currency, &UnknownCurrencyError{Code: code}
+}
+
+// CurrencyByNumericCode gets the currency object by numeric code, code is a 3 digits string like "036"
+// or an int like 36 that is zero padded
+func (r *Registry) CurrencyByNumericCode(code interface{}) (currency Currency, err error) {
+	var s string
+	switch v := code.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case int:
+		s = padNumericCode(int64(v))
+	case int64:
+		s = padNumericCode(v)
+	default:
+		return currency, fmt.Errorf("numeric code must be a string or an int, got %T", code)
+	}
+	if !isNumericCode(s) {
+		return currency, &UnknownCurrencyError{Code: s}
+	}
+
+	return r.CurrencyByCode(s)
+}
+
+func (r *Registry) MustCurrencyByCode(code string) Currency {
+	c, err := r.CurrencyByCode(code)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// Codes returns the registered codes sorted alphabetically
+func (r *Registry) Codes() []string {
+	r.mu.RLock()
+	codes := make([]string, 0, len(r.currencies))
+	for code := range r.currencies {
+		codes = append(codes, code)
+	}
+	r.mu.RUnlock()
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Forge
+// amount   int64  The amount in minor units
+// currCode string A code of the registry
+// it fails with *WithdrawnCurrencyError for a withdrawn currency when the registry refuses them
+func (r *Registry) Forge(amount int64, currCode string) (m Money, err error) {
+	c, err := r.forgeCurrency(currCode)
+	if err != nil {
+		return m, err
+	}
+
+	return ForgeWellFormed(amount, c), err
+}
+
+// read forges money read from a storage, the withdrawn currencies are always accepted
+func (r *Registry) read(amount int64, currCode string) (m Money, err error) {
+	c, err := r.CurrencyByCode(currCode)
+	if err != nil {
+		return m, err
+	}
+
+	return ForgeWellFormed(amount, c), err
+}
+
+func (r *Registry) MustForge(amount int64, currCode string) Money {
+	m, err := r.Forge(amount, currCode)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Parse Create a money object by a string like "PTS 123", the currency is looked up in the registry
+func (r *Registry) Parse(s string) (m Money, err error) {
+	return r.ParseWithFallback(s, Currency{})
+}
+
+// ParseWithFallback like Parse, fallbackCurr is used when s has no currency code
+func (r *Registry) ParseWithFallback(s string, fallbackCurr Currency) (m Money, err error) {
+	return parseWithFallback(s, fallbackCurr, r.CurrencyByCode)
+}
+
+// Scan reads an int64 amount in minor units of currCode or a string like "PTS 123",
+// the registry version of the package Scan
+func (r *Registry) Scan(value interface{}, currCode string) (m Money, err error) {
+	switch v := value.(type) {
+	case int64:
+		return r.read(v, currCode)
+	case string:
+		c, err := r.CurrencyByCode(currCode)
+		if err != nil {
+			return m, err
+		}
+		return r.ParseWithFallback(v, c)
+	case []byte:
+		return r.Scan(string(v), currCode)
+	}
+
+	return m, fmt.Errorf("impossible to get the money value from %v", value)
+}
+
+// UnmarshalMoney reads a money marshaled as json, the currency is looked up in the registry
+func (r *Registry) UnmarshalMoney(data []byte) (m Money, err error) {
+	dto := DTO{}
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return m, err
+	}
+	if dto.Currency == "" {
+		return m, &ParseError{Input: string(data), Reason: "missing currency"}
+	}
+
+	return r.ExtractMoney(dto)
+}
+
+// ExtractMoney is DTO.ExtractMoney with the currency looked up in the registry
+func (r *Registry) ExtractMoney(d DTO) (m Money, err error) {
+	return r.read(d.Amount, d.Currency)
+}
+
+// isNumericCode tells whether code is an ISO numeric code, exactly 3 digits like "036"
+func isNumericCode(code string) bool {
+	return len(code) == 3 && isDigits(code)
+}
+
+// isDigits tells whether s is made of digits only
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// padNumericCode formats an int numeric code with leading zeros, 36 is "036"
+func padNumericCode(code int64) string {
+	if code < 0 || code > 999 {
+		return strconv.FormatInt(code, 10)
+	}
+
+	return fmt.Sprintf("%03d", code)
+}
@@ -0,0 +1,124 @@
+package money_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+var points = money.Currency{Code: "PTS", MinorUnit: 0, Symbol: "★"}
+
+func TestRegisterCurrency(t *testing.T) {
+	_, err := money.Forge(100, "PTS")
+	assert.True(t, errors.Is(err, &money.UnknownCurrencyError{Code: "PTS"}))
+
+	assert.Nil(t, money.RegisterCurrency(points))
+	defer money.UnregisterCurrency("PTS")
+
+	assert.NotNil(t, money.RegisterCurrency(points), "already registered")
+	assert.NotNil(t, money.RegisterCurrency(money.Currency{Code: " "}))
+	assert.NotNil(t, money.RegisterCurrency(money.Currency{Code: "BAD", MinorUnit: -1}))
+
+	m, err := money.Forge(100, "pts")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(150), m.MustAdd(money.MustForge(50, "PTS")).Int64())
+
+	p, err := money.Parse("PTS 20")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), p.Int64())
+
+	assert.Nil(t, money.UnregisterCurrency("PTS"))
+	assert.NotNil(t, money.UnregisterCurrency("PTS"))
+	_, err = money.Parse("PTS 20")
+	assert.NotNil(t, err)
+	// already forged moneys keep working
+	assert.Equal(t, "PTS", m.MustMultiply(2).Currency.Code)
+}
+
+func TestRegistry_isolated(t *testing.T) {
+	credits := money.Currency{Code: "CRD", MinorUnit: 2, Symbol: "¢"}
+	r := money.MustNewRegistry(points, credits)
+
+	assert.Equal(t, []string{"CRD", "PTS"}, r.Codes())
+	_, err := r.Forge(1, "EUR")
+	assert.NotNil(t, err)
+	_, err = money.Forge(1, "CRD")
+	assert.NotNil(t, err, "not visible in the default registry")
+
+	m := r.MustForge(1050, "CRD")
+	assert.Equal(t, "10.50", m.AmountAsString())
+	assert.Equal(t, "CRD", m.MustAdd(m).Currency.Code, "arithmetic keeps the currency")
+
+	parsed, err := r.Parse("crd 1050")
+	assert.Nil(t, err)
+	assert.Equal(t, m, parsed)
+
+	b, err := m.MarshalJSON()
+	assert.Nil(t, err)
+	got, err := r.UnmarshalMoney(b)
+	assert.Nil(t, err)
+	assert.Equal(t, m, got)
+	_, err = r.UnmarshalMoney([]byte(`{"amount":1,"currency":"EUR"}`))
+	assert.NotNil(t, err)
+
+	got, err = r.Scan(int64(1050), "CRD")
+	assert.Nil(t, err)
+	assert.Equal(t, m, got)
+	got, err = r.Scan([]byte("PTS 3"), "CRD")
+	assert.Nil(t, err)
+	assert.Equal(t, r.MustForge(3, "PTS"), got)
+	_, err = r.Scan(1.5, "CRD")
+	assert.NotNil(t, err)
+
+	// validity is tied to a registry, ForgeWithCurrency only knows the default one
+	assert.True(t, credits.IsValidIn(r))
+	assert.False(t, credits.IsValid())
+	assert.False(t, money.MustGetCurrencyByISOCode("EUR").IsValidIn(r))
+	assert.Equal(t, "EUR", money.ForgeWithCurrency(0, credits).Currency.Code)
+
+	// the helpers taking a currency keep a well formed one without looking it up
+	assert.Equal(t, m.MustMultiply(0), money.ForgeWellFormed(0, credits))
+	assert.Equal(t, "CRD 5", money.ForgeBigWithCurrency(big.NewInt(5), credits).String())
+	rat, err := money.ForgeRatWithCurrency(big.NewRat(3, 2), credits, money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, r.MustForge(2, "CRD"), rat)
+	scanned := money.Money{Currency: credits}
+	assert.Nil(t, scanned.Scan(int64(7)))
+	assert.Equal(t, r.MustForge(7, "CRD"), scanned)
+	assert.Nil(t, scanned.ScanInt64(int64(8)))
+	assert.Equal(t, r.MustForge(8, "CRD"), scanned)
+	total, err := money.MultiMoney{}.Total(credits, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "CRD", total.Currency.Code)
+
+	iso := money.NewISORegistry()
+	assert.Equal(t, money.EUR(1), iso.MustForge(1, "EUR"))
+	_, err = money.NewRegistry(points, points)
+	assert.NotNil(t, err)
+}
+
+func TestRegistry_concurrent(t *testing.T) {
+	r := money.MustNewRegistry()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		code := fmt.Sprintf("C%02d", i)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, r.Register(money.Currency{Code: code, MinorUnit: 2}))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = r.Forge(1, code)
+			_ = r.Codes()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, r.Codes(), 50)
+}
@@ -0,0 +1,214 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Replacement is the switch from a withdrawn currency to its successor
+type Replacement struct {
+	Old string
+	New string
+	// Date is the first day Old isn't legal tender anymore
+	Date time.Time
+	// Rate is the units of New for one unit of Old fixed by law, nil when there isn't a fixed rate
+	Rate *big.Rat
+}
+
+// replacements are the ISO 4217 replacements of the catalog currencies
+var replacements = []Replacement{
+	isoReplacement("HRK", "EUR", big.NewRat(10000, 75345)),
+	isoReplacement("MRO", "MRU", big.NewRat(1, 10)),
+	isoReplacement("STD", "STN", big.NewRat(1, 1000)),
+	isoReplacement("VEF", "VES", big.NewRat(1, 100000)),
+	isoReplacement("ZWD", "ZWR", nil),
+	isoReplacement("ZWR", "ZWL", nil),
+	isoReplacement("ZWL", "ZWG", nil),
+}
+
+// isoReplacement dates the replacement of a catalog currency with its ValidTo so the two can't disagree
+func isoReplacement(from, to string, rate *big.Rat) Replacement {
+	return Replacement{Old: from, New: to, Date: currencies[from].ValidTo, Rate: rate}
+}
+
+// RegisterReplacement adds a replacement to the default registry, see Registry.RegisterReplacement
+func RegisterReplacement(rep Replacement) error {
+	return defaultRegistry.RegisterReplacement(rep)
+}
+
+// ReplacementOf returns the replacement of a currency of the default registry
+func ReplacementOf(code string) (Replacement, bool) {
+	return defaultRegistry.ReplacementOf(code)
+}
+
+// Successor returns the currency of the default registry that replaces code at a date, see Registry.Successor
+func Successor(code string, at time.Time) (Currency, error) {
+	return defaultRegistry.Successor(code, at)
+}
+
+// Redenominate converts money of a withdrawn currency of the default registry, see Registry.Redenominate
+func Redenominate(m Money, mode RoundingMode) (Money, error) {
+	return defaultRegistry.Redenominate(m, mode)
+}
+
+// RefuseWithdrawnCurrencies makes Forge and ForgeFloat fail with *WithdrawnCurrencyError
+// for the currencies that aren't legal tender today, see Registry.RefuseWithdrawn
+func RefuseWithdrawnCurrencies(refuse bool) {
+	defaultRegistry.RefuseWithdrawn(refuse)
+}
+
+// SetClock sets the clock of the default registry, see Registry.SetClock
+func SetClock(now func() time.Time) {
+	defaultRegistry.SetClock(now)
+}
+
+// RegisterReplacement adds a replacement, both currencies must be registered, New must be active at Date
+// and Old can be replaced only once
+func (r *Registry) RegisterReplacement(rep Replacement) error {
+	rep.Old, rep.New = strings.ToUpper(rep.Old), strings.ToUpper(rep.New)
+	if rep.Old == rep.New {
+		return errors.New("a currency can't replace itself")
+	}
+	if rep.Rate != nil && rep.Rate.Sign() <= 0 {
+		return fmt.Errorf("replacement rate of %s must be positive", rep.Old)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, code := range []string{rep.Old, rep.New} {
+		if _, ok := r.currencies[code]; !ok {
+			return &UnknownCurrencyError{Code: code}
+		}
+	}
+	if !r.currencies[rep.New].IsActiveAt(rep.Date) {
+		return fmt.Errorf("currency %s isn't active on %s to replace %s", rep.New, rep.Date.Format("2006-01-02"), rep.Old)
+	}
+	if _, ok := r.replacements[rep.Old]; ok {
+		return fmt.Errorf("currency %s already has a replacement", rep.Old)
+	}
+	if r.replacements == nil {
+		r.replacements = map[string]Replacement{}
+	}
+	r.replacements[rep.Old] = rep
+
+	return nil
+}
+
+// ReplacementOf returns the replacement of a currency, false when it was never replaced
+func (r *Registry) ReplacementOf(code string) (rep Replacement, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rep, ok = r.replacements[strings.ToUpper(code)]
+	if rep.Rate != nil {
+		rep.Rate = new(big.Rat).Set(rep.Rate)
+	}
+
+	return rep, ok
+}
+
+// Successor follows the replacements of a currency up to the one in use at a date,
+// e.g. ZWD is ZWL in 2020 and ZWG in 2025. A currency never replaced is its own successor
+func (r *Registry) Successor(code string, at time.Time) (Currency, error) {
+	c, err := r.CurrencyByCode(code)
+	if err != nil {
+		return c, err
+	}
+
+	// the count of currencies bounds the chain even with a cycle
+	limit := len(r.Codes())
+	for i := 0; i < limit; i++ {
+		rep, ok := r.ReplacementOf(c.Code)
+		if !ok || at.Before(rep.Date) {
+			return c, nil
+		}
+		if c, err = r.CurrencyByCode(rep.New); err != nil {
+			return c, err
+		}
+	}
+
+	return c, fmt.Errorf("replacements of %s have a cycle", code)
+}
+
+// Redenominate converts money of a replaced currency to its replacement with the fixed rate, rounded with mode
+func (r *Registry) Redenominate(m Money, mode RoundingMode) (s Money, err error) {
+	rep, ok := r.ReplacementOf(m.Currency.Code)
+	if !ok {
+		return s, fmt.Errorf("currency %s has no replacement", m.Currency.Code)
+	}
+	if rep.Rate == nil {
+		return s, fmt.Errorf("replacement of %s by %s has no fixed rate", rep.Old, rep.New)
+	}
+	c, err := r.CurrencyByCode(rep.New)
+	if err != nil {
+		return s, err
+	}
+
+	// minor units of New = minor units of Old * rate * 10^(New minor unit - Old minor unit)
+	amount := new(big.Rat).SetInt64(m.Amount.Int64())
+	amount.Mul(amount, rep.Rate)
+	shift := new(big.Rat).SetInt(pow10(abs(c.MinorUnit - m.Currency.MinorUnit)))
+	if c.MinorUnit >= m.Currency.MinorUnit {
+		amount.Mul(amount, shift)
+	} else {
+		amount.Quo(amount, shift)
+	}
+
+	return ForgeRatWithCurrency(amount, c, mode)
+}
+
+// RefuseWithdrawn makes Forge fail with *WithdrawnCurrencyError for the currencies that aren't legal tender today.
+// Parse, Scan, ExtractMoney and UnmarshalMoney keep reading them so historical data stays readable
+func (r *Registry) RefuseWithdrawn(refuse bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refuseWithdrawn = refuse
+}
+
+// SetClock sets the clock telling what today is for Forge and the region functions, nil is time.Now.
+// A fixed clock makes them deterministic in tests and replays
+func (r *Registry) SetClock(now func() time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = now
+}
+
+// today returns the time of the registry clock
+func (r *Registry) today() time.Time {
+	r.mu.RLock()
+	now := r.now
+	r.mu.RUnlock()
+	if now == nil {
+		return time.Now()
+	}
+
+	return now()
+}
+
+// forgeCurrency is CurrencyByCode for forging new money, it refuses the withdrawn currencies when asked
+func (r *Registry) forgeCurrency(code string) (c Currency, err error) {
+	c, err = r.CurrencyByCode(code)
+	if err != nil {
+		return c, err
+	}
+
+	r.mu.RLock()
+	refuse := r.refuseWithdrawn
+	rep := r.replacements[c.Code]
+	r.mu.RUnlock()
+	if refuse && !c.IsActiveAt(r.today()) {
+		return Currency{}, &WithdrawnCurrencyError{Code: c.Code, ValidTo: c.ValidTo, Replacement: rep.New}
+	}
+
+	return c, err
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+
+	return i
+}
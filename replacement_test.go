@@ -0,0 +1,206 @@
+package money_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCurrency_IsActiveAt(t *testing.T) {
+	hrk := money.MustGetCurrencyByISOCode("HRK")
+	assert.True(t, hrk.IsActiveAt(day(2022, time.December, 31)))
+	assert.False(t, hrk.IsActiveAt(day(2023, time.January, 1)))
+
+	zwl := money.MustGetCurrencyByISOCode("ZWL")
+	assert.False(t, zwl.IsActiveAt(day(2009, time.February, 1)))
+	assert.True(t, zwl.IsActiveAt(day(2020, time.May, 5)))
+	assert.False(t, zwl.IsActiveAt(day(2024, time.September, 1)))
+
+	assert.True(t, money.MustGetCurrencyByISOCode("EUR").IsActiveAt(time.Now()))
+	assert.True(t, money.MustGetCurrencyByISOCode("MRU").IsActiveAt(time.Now()))
+	assert.Equal(t, 2, money.MustGetCurrencyByISOCode("STN").MinorUnit)
+	assert.Equal(t, "924", money.MustGetCurrencyByISOCode("ZWG").NumericCode)
+}
+
+func TestSuccessor(t *testing.T) {
+	tests := []struct {
+		code string
+		at   time.Time
+		want string
+	}{
+		{"HRK", day(2022, time.June, 1), "HRK"},
+		{"HRK", day(2023, time.June, 1), "EUR"},
+		{"MRO", day(2019, time.June, 1), "MRU"},
+		{"STD", day(2019, time.June, 1), "STN"},
+		{"VEF", day(2019, time.June, 1), "VES"},
+		{"ZWD", day(2008, time.July, 31), "ZWD"},
+		{"ZWD", day(2008, time.September, 1), "ZWR"},
+		{"ZWD", day(2009, time.February, 2), "ZWL"},
+		{"ZWD", day(2020, time.June, 1), "ZWL"},
+		{"ZWD", day(2025, time.June, 1), "ZWG"},
+		{"EUR", day(2025, time.June, 1), "EUR"},
+	}
+	for _, tt := range tests {
+		c, err := money.Successor(tt.code, tt.at)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, c.Code, "%s at %s", tt.code, tt.at)
+	}
+
+	rep, ok := money.ReplacementOf("hrk")
+	assert.True(t, ok)
+	assert.Equal(t, "EUR", rep.New)
+	assert.Equal(t, "2000/15069", rep.Rate.String())
+	_, ok = money.ReplacementOf("EUR")
+	assert.False(t, ok)
+}
+
+func TestReplacements_successorIsActive(t *testing.T) {
+	iso := money.NewISORegistry()
+	for _, code := range iso.Codes() {
+		rep, ok := iso.ReplacementOf(code)
+		if !ok {
+			continue
+		}
+		assert.False(t, rep.Date.IsZero(), code)
+		assert.Equal(t, iso.MustCurrencyByCode(code).ValidTo, rep.Date, "%s is replaced when it's withdrawn", code)
+		assert.True(t, iso.MustCurrencyByCode(rep.New).IsActiveAt(rep.Date), "%s replaces %s", rep.New, rep.Old)
+		c, err := iso.Successor(code, rep.Date)
+		assert.Nil(t, err)
+		assert.True(t, c.IsActiveAt(rep.Date), "successor of %s", code)
+	}
+}
+
+func TestRedenominate(t *testing.T) {
+	// HRK 753.45 is EUR 100
+	eur, err := money.Redenominate(money.HRK(75345), money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(10000), eur)
+
+	// MRO has no decimals, MRU has 2
+	mru, err := money.Redenominate(money.MRO(1234), money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.MRU(12340), mru)
+
+	stn, err := money.Redenominate(money.STD(123456), money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.STN(123), stn)
+
+	_, err = money.Redenominate(money.ZWL(100), money.RoundHalfUp)
+	assert.NotNil(t, err, "no fixed rate")
+	_, err = money.Redenominate(money.EUR(100), money.RoundHalfUp)
+	assert.NotNil(t, err)
+}
+
+func TestRefuseWithdrawnCurrencies(t *testing.T) {
+	money.RefuseWithdrawnCurrencies(true)
+	defer money.RefuseWithdrawnCurrencies(false)
+
+	_, err := money.Forge(100, "HRK")
+	var withdrawn *money.WithdrawnCurrencyError
+	assert.True(t, errors.As(err, &withdrawn))
+	assert.Equal(t, "EUR", withdrawn.Replacement)
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+	_, err = money.ForgeFloat(1, "VEF")
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{}))
+
+	_, err = money.Forge(100, "EUR")
+	assert.Nil(t, err)
+
+	// every new amount is refused, whatever the type
+	_, err = money.ForgeBig(big.NewInt(1), "HRK")
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+	_, err = money.ForgePrice(1, 2, "HRK")
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+	_, err = money.ParsePrice("HRK 0.0001", 2)
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+
+	// historical data is still readable
+	m, err := money.Parse("HRK 100")
+	assert.Nil(t, err)
+	assert.Equal(t, "HRK", m.Currency.Code)
+	m, err = money.Scan(int64(100), "HRK")
+	assert.Nil(t, err)
+	assert.Equal(t, "HRK", m.Currency.Code)
+	assert.Nil(t, m.UnmarshalJSON([]byte(`{"amount":100,"currency":"MRO"}`)))
+	assert.Equal(t, "MRO", m.Currency.Code)
+}
+
+func TestRegistry_replacements(t *testing.T) {
+	old := money.Currency{Code: "OLD", MinorUnit: 2}
+	r := money.MustNewRegistry(old, money.Currency{Code: "NEW", MinorUnit: 2})
+	assert.Nil(t, r.RegisterReplacement(money.Replacement{Old: "old", New: "NEW", Date: day(2020, time.January, 1)}))
+	assert.NotNil(t, r.RegisterReplacement(money.Replacement{Old: "OLD", New: "NEW"}), "already replaced")
+	assert.NotNil(t, r.RegisterReplacement(money.Replacement{Old: "NEW", New: "XYZ"}))
+	assert.NotNil(t, r.RegisterReplacement(money.Replacement{Old: "NEW", New: "NEW"}))
+
+	c, err := r.Successor("OLD", day(2021, time.January, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, "NEW", c.Code)
+
+	// the replacing currency must be active at the date
+	later := money.Currency{Code: "LTR", MinorUnit: 2, ValidFrom: day(2022, time.January, 1)}
+	assert.Nil(t, r.Register(later))
+	assert.NotNil(t, r.RegisterReplacement(money.Replacement{Old: "NEW", New: "LTR", Date: day(2021, time.January, 1)}))
+
+	// a cycle doesn't loop forever
+	assert.Nil(t, r.RegisterReplacement(money.Replacement{Old: "NEW", New: "OLD", Date: day(2020, time.January, 1)}))
+	_, err = r.Successor("OLD", day(2021, time.January, 1))
+	assert.NotNil(t, err)
+}
+
+func TestRegistry_unregisterReplacement(t *testing.T) {
+	r := money.MustNewRegistry(money.Currency{Code: "OLD", MinorUnit: 2}, money.Currency{Code: "NEW", MinorUnit: 2})
+	assert.Nil(t, r.RegisterReplacement(money.Replacement{Old: "OLD", New: "NEW", Date: day(2020, time.January, 1)}))
+
+	assert.Nil(t, r.Unregister("NEW"))
+	_, ok := r.ReplacementOf("OLD")
+	assert.False(t, ok, "no dangling replacement")
+	c, err := r.Successor("OLD", day(2021, time.January, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, "OLD", c.Code)
+}
+
+func TestSetClock(t *testing.T) {
+	money.SetClock(func() time.Time { return day(2022, time.June, 1) })
+	defer money.SetClock(nil)
+	money.RefuseWithdrawnCurrencies(true)
+	defer money.RefuseWithdrawnCurrencies(false)
+
+	_, err := money.Forge(100, "HRK")
+	assert.Nil(t, err, "HRK is legal tender in 2022")
+	c, err := money.DefaultCurrencyForLocale("hr-HR")
+	assert.Nil(t, err)
+	assert.Equal(t, "HRK", c.Code)
+	regions, err := money.RegionsForCurrency("EUR")
+	assert.Nil(t, err)
+	assert.NotContains(t, regions, "HR")
+
+	money.SetClock(func() time.Time { return day(2023, time.June, 1) })
+	_, err = money.Forge(100, "HRK")
+	assert.True(t, errors.Is(err, &money.WithdrawnCurrencyError{Code: "HRK"}))
+	cs, err := money.CurrenciesForRegion("HR")
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR", cs[0].Code)
+}
+
+func TestCurrenciesForRegion_withdrawn(t *testing.T) {
+	cs, err := money.CurrenciesForRegion("HR")
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR", cs[0].Code)
+
+	regions, err := money.RegionsForCurrency("EUR")
+	assert.Nil(t, err)
+	assert.Contains(t, regions, "HR")
+
+	c, err := money.DefaultCurrencyForLocale("hr-HR")
+	assert.Nil(t, err)
+	assert.Equal(t, "EUR", c.Code)
+}
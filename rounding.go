@@ -0,0 +1,110 @@
+package money
+
+import (
+	"math/big"
+)
+
+// RoundingMode tells how a result that falls between two minor units is rounded
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest neighbour, ties go to the even one (banker's rounding)
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest neighbour, ties go away from zero
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbour, ties go towards zero
+	RoundHalfDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundDown rounds towards zero (truncation)
+	RoundDown
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+)
+
+func (r RoundingMode) String() string {
+	switch r {
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	}
+
+	return "Unknown"
+}
+
+// quoRound returns n/d rounded with the given mode, d must not be zero
+func quoRound(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// sign of the exact quotient, q is truncated towards zero
+	sign := n.Sign() * d.Sign()
+
+	awayFromZero := false
+	switch mode {
+	case RoundUp:
+		awayFromZero = true
+	case RoundDown:
+		awayFromZero = false
+	case RoundCeiling:
+		awayFromZero = sign > 0
+	case RoundFloor:
+		awayFromZero = sign < 0
+	default:
+		// compare the remainder with the half of the divisor
+		twiceR := new(big.Int).Abs(r)
+		twiceR.Lsh(twiceR, 1)
+		cmp := twiceR.Cmp(new(big.Int).Abs(d))
+		switch {
+		case cmp > 0:
+			awayFromZero = true
+		case cmp < 0:
+			awayFromZero = false
+		case mode == RoundHalfUp:
+			awayFromZero = true
+		case mode == RoundHalfDown:
+			awayFromZero = false
+		default:
+			awayFromZero = q.Bit(0) == 1
+		}
+	}
+
+	if awayFromZero {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+
+	return q
+}
+
+// ForgeRatWithCurrency forges money from an exact fraction of minor units, rounded with mode,
+// the currency is kept like ForgeWellFormed. It fails with ErrOverflow when the rounded amount doesn't fit int64
+func ForgeRatWithCurrency(amount *big.Rat, c Currency, mode RoundingMode) (m Money, err error) {
+	q := quoRound(amount.Num(), amount.Denom(), mode)
+	if !q.IsInt64() {
+		return m, ErrOverflow
+	}
+
+	return ForgeWellFormed(q.Int64(), c), err
+}
+
+// MultiplyRat returns the money multiplied by an exact fraction, rounded to the minor unit with mode
+func (m Money) MultiplyRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	n := new(big.Rat).SetInt64(m.Amount.Int64())
+
+	return ForgeRatWithCurrency(n.Mul(n, r), m.Currency, mode)
+}
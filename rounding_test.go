@@ -0,0 +1,92 @@
+package money_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Divide(t *testing.T) {
+	tests := []struct {
+		amount  int64
+		divisor int64
+		mode    money.RoundingMode
+		want    int64
+	}{
+		{25, 10, money.RoundHalfEven, 2},
+		{35, 10, money.RoundHalfEven, 4},
+		{-25, 10, money.RoundHalfEven, -2},
+		{25, 10, money.RoundHalfUp, 3},
+		{-25, 10, money.RoundHalfUp, -3},
+		{25, 10, money.RoundHalfDown, 2},
+		{26, 10, money.RoundHalfDown, 3},
+		{21, 10, money.RoundUp, 3},
+		{-21, 10, money.RoundUp, -3},
+		{29, 10, money.RoundDown, 2},
+		{-29, 10, money.RoundDown, -2},
+		{21, 10, money.RoundCeiling, 3},
+		{-29, 10, money.RoundCeiling, -2},
+		{29, 10, money.RoundFloor, 2},
+		{-21, 10, money.RoundFloor, -3},
+		{21, -10, money.RoundFloor, -3},
+		{100, 3, money.RoundHalfEven, 33},
+		{100, 1, money.RoundHalfEven, 100},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d %s", tt.amount, tt.divisor, tt.mode), func(t *testing.T) {
+			got, err := money.EUR(tt.amount).Divide(tt.divisor, tt.mode)
+			assert.Nil(t, err)
+			assert.Equal(t, money.EUR(tt.want), got)
+		})
+	}
+}
+
+func TestMoney_DivideByZero(t *testing.T) {
+	_, err := money.EUR(100).Divide(0, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	assert.Panics(t, func() { money.EUR(100).MustDivide(0, money.RoundHalfEven) })
+}
+
+func TestMoney_Multiply(t *testing.T) {
+	got, err := money.EUR(100).Multiply(3)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(300), got)
+
+	assert.Equal(t, money.JPY(-300), money.JPY(100).MustMultiply(-3))
+}
+
+func TestMoney_MultiplyRatio(t *testing.T) {
+	// 19.99 * 7/3 = 46.643...
+	got, err := money.EUR(1999).MultiplyRatio(7, 3, money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(4664), got)
+
+	// the intermediate product doesn't fit int64 but the result does
+	got, err = money.EUR(math.MaxInt64/2).MultiplyRatio(4, 4, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(math.MaxInt64/2), got)
+
+	_, err = money.EUR(math.MaxInt64).MultiplyRatio(2, 1, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	_, err = money.EUR(100).MultiplyRatio(1, 0, money.RoundHalfEven)
+	assert.NotNil(t, err)
+}
+
+func TestMoney_MultiplyRat(t *testing.T) {
+	got, err := money.EUR(1000).MultiplyRat(big.NewRat(9975, 100000), money.RoundHalfUp)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(100), got)
+
+	got, err = money.EUR(-1000).MultiplyRat(big.NewRat(9975, 100000), money.RoundDown)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(-99), got)
+
+	_, err = money.EUR(math.MaxInt64).MultiplyRat(big.NewRat(3, 2), money.RoundHalfEven)
+	assert.Equal(t, money.ErrOverflow, err)
+}
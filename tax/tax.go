@@ -0,0 +1,308 @@
+package tax
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/radical-app/money"
+)
+
+// Rate is a tax rate like VAT 22% or QST 9.975%.
+// A compound rate is applied on the net amount plus all the taxes before it in the list.
+type Rate struct {
+	Name     string   `json:"name"`
+	Percent  *big.Rat `json:"percent"`
+	Compound bool     `json:"compound"`
+}
+
+// ForgeRate
+// name     string The tax name, e.g. "VAT", the invoices group the taxes by name, percent and compound
+// percent  string The exact percentage like "22" or "9.975"
+// compound bool   Whether the rate applies on the net amount plus the previous taxes
+func ForgeRate(name, percent string, compound bool) (r Rate, err error) {
+	p, ok := new(big.Rat).SetString(strings.TrimSuffix(strings.TrimSpace(percent), "%"))
+	if !ok {
+		return r, fmt.Errorf("invalid tax percent %s", percent)
+	}
+	if p.Sign() < 0 {
+		return r, fmt.Errorf("tax percent can't be negative %s", percent)
+	}
+
+	return Rate{Name: name, Percent: p, Compound: compound}, err
+}
+
+func MustForgeRate(name, percent string, compound bool) Rate {
+	r, err := ForgeRate(name, percent, compound)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// rateDTO is the json form of a Rate, the percent is a decimal string like "9.975"
+type rateDTO struct {
+	Name     string `json:"name"`
+	Percent  string `json:"percent"`
+	Compound bool   `json:"compound"`
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rateDTO{Name: r.Name, Percent: r.percentString(), Compound: r.Compound})
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	dto := rateDTO{}
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+
+	rate, err := ForgeRate(dto.Name, dto.Percent, dto.Compound)
+	if err != nil {
+		return err
+	}
+	*r = rate
+
+	return nil
+}
+
+// percentString returns the exact percent as decimal, or as fraction like "1/3" when it has no finite decimal form
+func (r Rate) percentString() string {
+	if r.Percent == nil {
+		return "0"
+	}
+
+	// a fraction has a finite decimal form when its denominator has no prime factors other than 2 and 5
+	denom := new(big.Int).Set(r.Percent.Denom())
+	twos, fives := 0, 0
+	two, five, mod := big.NewInt(2), big.NewInt(5), new(big.Int)
+	for mod.Mod(denom, two).Sign() == 0 {
+		denom.Quo(denom, two)
+		twos++
+	}
+	for mod.Mod(denom, five).Sign() == 0 {
+		denom.Quo(denom, five)
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return r.Percent.RatString()
+	}
+	if fives > twos {
+		twos = fives
+	}
+
+	return r.Percent.FloatString(twos)
+}
+
+// fraction returns the rate as fraction, 22% is 22/100
+func (r Rate) fraction() *big.Rat {
+	if r.Percent == nil {
+		return new(big.Rat)
+	}
+
+	return new(big.Rat).Quo(r.Percent, big.NewRat(100, 1))
+}
+
+// rateKey identifies a rate on an invoice, "VAT" 22% and "VAT" 10% are two different taxes
+type rateKey struct {
+	name     string
+	percent  string
+	compound bool
+}
+
+func (r Rate) key() rateKey {
+	percent := "0"
+	if r.Percent != nil {
+		percent = r.Percent.RatString()
+	}
+
+	return rateKey{name: r.Name, percent: percent, compound: r.Compound}
+}
+
+// Amount is the tax due for a rate
+type Amount struct {
+	Rate   Rate        `json:"rate"`
+	Amount money.Money `json:"amount"`
+}
+
+// Breakdown splits a gross amount in net and taxes, Net plus all the Taxes is always exactly Gross
+type Breakdown struct {
+	Net   money.Money `json:"net"`
+	Gross money.Money `json:"gross"`
+	Taxes []Amount    `json:"taxes"`
+}
+
+// Tax returns the sum of all the taxes
+func (b Breakdown) Tax() money.Money {
+	return b.Gross.MustSubtract(b.Net)
+}
+
+// Exclusive computes the taxes on a net amount, the gross is net plus the rounded taxes
+func Exclusive(net money.Money, mode money.RoundingMode, rates ...Rate) (b Breakdown, err error) {
+	exact := exactTaxes(new(big.Rat).SetInt64(net.Int64()), rates)
+
+	return breakdown(net, false, exact, rates, mode)
+}
+
+func MustExclusive(net money.Money, mode money.RoundingMode, rates ...Rate) Breakdown {
+	b, err := Exclusive(net, mode, rates...)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// Inclusive extracts the taxes from a gross amount, the net is gross minus the rounded taxes
+func Inclusive(gross money.Money, mode money.RoundingMode, rates ...Rate) (b Breakdown, err error) {
+	exact := exactTaxes(exactNet(gross, rates), rates)
+
+	return breakdown(gross, true, exact, rates, mode)
+}
+
+func MustInclusive(gross money.Money, mode money.RoundingMode, rates ...Rate) Breakdown {
+	b, err := Inclusive(gross, mode, rates...)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// exactTaxes returns the unrounded taxes in minor units for an exact net
+func exactTaxes(net *big.Rat, rates []Rate) []*big.Rat {
+	taxes := make([]*big.Rat, len(rates))
+	previous := new(big.Rat)
+	for i, r := range rates {
+		base := new(big.Rat).Set(net)
+		if r.Compound {
+			base.Add(base, previous)
+		}
+		taxes[i] = base.Mul(base, r.fraction())
+		previous = new(big.Rat).Add(previous, taxes[i])
+	}
+
+	return taxes
+}
+
+// exactNet returns the unrounded net in minor units that gives gross once taxed
+func exactNet(gross money.Money, rates []Rate) *big.Rat {
+	// the gross of one unit of net
+	multiplier := new(big.Rat).SetInt64(1)
+	for _, t := range exactTaxes(big.NewRat(1, 1), rates) {
+		multiplier.Add(multiplier, t)
+	}
+
+	net := new(big.Rat).SetInt64(gross.Int64())
+
+	return net.Quo(net, multiplier)
+}
+
+// breakdown rounds the exact taxes, amount is the gross when inclusive is true, the net otherwise
+func breakdown(amount money.Money, inclusive bool, exact []*big.Rat, rates []Rate, mode money.RoundingMode) (b Breakdown, err error) {
+	b.Taxes = make([]Amount, len(rates))
+	total := money.ForgeWellFormed(0, amount.Currency)
+	for i, t := range exact {
+		tax, err := money.ForgeRatWithCurrency(t, amount.Currency, mode)
+		if err != nil {
+			return Breakdown{}, err
+		}
+		b.Taxes[i] = Amount{Rate: rates[i], Amount: tax}
+		total, err = total.Add(tax)
+		if err != nil {
+			return Breakdown{}, err
+		}
+	}
+
+	if inclusive {
+		b.Gross = amount
+		b.Net, err = amount.Subtract(total)
+		return b, err
+	}
+
+	b.Net = amount
+	b.Gross, err = amount.Add(total)
+
+	return b, err
+}
+
+// Policy tells when the taxes of an invoice are rounded
+type Policy int
+
+const (
+	// RoundPerLine rounds the taxes of every line, the invoice taxes are the sum of the rounded line taxes
+	RoundPerLine Policy = iota
+	// RoundPerInvoice sums the exact taxes of all lines by rate and rounds only the totals
+	RoundPerInvoice
+)
+
+// Line is an invoice line, Amount is gross when the invoice is tax inclusive, net otherwise
+type Line struct {
+	Amount money.Money
+	Rates  []Rate
+}
+
+// Invoice is the tax breakdown of a list of lines.
+// Lines are always rounded one by one, with RoundPerInvoice their taxes may not add up to the invoice ones.
+type Invoice struct {
+	Breakdown
+	Lines []Breakdown `json:"lines"`
+}
+
+// ComputeInvoice computes the taxes of all the lines, they must have the same currency
+func ComputeInvoice(lines []Line, inclusive bool, policy Policy, mode money.RoundingMode) (inv Invoice, err error) {
+	if len(lines) == 0 {
+		return inv, errors.New("can't compute an invoice without lines")
+	}
+
+	currency := lines[0].Amount.Currency
+	amount := money.ForgeWellFormed(0, currency)
+	// exact taxes grouped by name, percent and compound, in order of appearance
+	var rates []Rate
+	exactByRate := map[rateKey]*big.Rat{}
+
+	inv.Lines = make([]Breakdown, len(lines))
+	for i, l := range lines {
+		amount, err = amount.Add(l.Amount)
+		if err != nil {
+			return Invoice{}, err
+		}
+
+		var exact []*big.Rat
+		if inclusive {
+			exact = exactTaxes(exactNet(l.Amount, l.Rates), l.Rates)
+		} else {
+			exact = exactTaxes(new(big.Rat).SetInt64(l.Amount.Int64()), l.Rates)
+		}
+
+		inv.Lines[i], err = breakdown(l.Amount, inclusive, exact, l.Rates, mode)
+		if err != nil {
+			return Invoice{}, err
+		}
+
+		for j, r := range l.Rates {
+			k := r.key()
+			if _, ok := exactByRate[k]; !ok {
+				exactByRate[k] = new(big.Rat)
+				rates = append(rates, r)
+			}
+			t := exact[j]
+			if policy == RoundPerLine {
+				t = new(big.Rat).SetInt64(inv.Lines[i].Taxes[j].Amount.Int64())
+			}
+			exactByRate[k].Add(exactByRate[k], t)
+		}
+	}
+
+	exact := make([]*big.Rat, len(rates))
+	for i, r := range rates {
+		exact[i] = exactByRate[r.key()]
+	}
+
+	inv.Breakdown, err = breakdown(amount, inclusive, exact, rates, mode)
+
+	return inv, err
+}
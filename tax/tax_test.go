@@ -0,0 +1,163 @@
+package tax
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radical-app/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func sumsUp(t *testing.T, b Breakdown) {
+	total := b.Net
+	for _, tx := range b.Taxes {
+		total = total.MustAdd(tx.Amount)
+	}
+	assert.True(t, total.IsEquals(b.Gross), "net + taxes = %s, gross %s", total.String(), b.Gross.String())
+}
+
+func TestExclusive(t *testing.T) {
+	vat := MustForgeRate("VAT", "22%", false)
+
+	b, err := Exclusive(money.EUR(1999), money.RoundHalfEven, vat)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(1999), b.Net)
+	assert.Equal(t, money.EUR(440), b.Taxes[0].Amount)
+	assert.Equal(t, money.EUR(2439), b.Gross)
+	assert.Equal(t, money.EUR(440), b.Tax())
+	sumsUp(t, b)
+}
+
+func TestInclusive(t *testing.T) {
+	gst := MustForgeRate("GST", "5", false)
+	qst := MustForgeRate("QST", "9.975", false)
+
+	b, err := Inclusive(money.CAD(11498), money.RoundHalfUp, gst, qst)
+	assert.Nil(t, err)
+	assert.Equal(t, money.CAD(10000), b.Net)
+	assert.Equal(t, money.CAD(500), b.Taxes[0].Amount)
+	assert.Equal(t, money.CAD(998), b.Taxes[1].Amount)
+	sumsUp(t, b)
+
+	// 1 cent can't be split, it's all tax
+	b, err = Inclusive(money.EUR(1), money.RoundHalfEven, MustForgeRate("VAT", "22", false))
+	assert.Nil(t, err)
+	sumsUp(t, b)
+}
+
+func TestCompound(t *testing.T) {
+	// QST before 2013 was applied on the price including GST
+	gst := MustForgeRate("GST", "5", false)
+	qst := MustForgeRate("QST", "8.5", true)
+
+	b := MustExclusive(money.CAD(10000), money.RoundHalfUp, gst, qst)
+	assert.Equal(t, money.CAD(500), b.Taxes[0].Amount)
+	assert.Equal(t, money.CAD(893), b.Taxes[1].Amount)
+	assert.Equal(t, money.CAD(11393), b.Gross)
+	sumsUp(t, b)
+
+	// and back from the gross
+	b = MustInclusive(money.CAD(11393), money.RoundHalfUp, gst, qst)
+	assert.Equal(t, money.CAD(10000), b.Net)
+	sumsUp(t, b)
+}
+
+func TestForgeRate(t *testing.T) {
+	_, err := ForgeRate("VAT", "abc", false)
+	assert.NotNil(t, err)
+
+	_, err = ForgeRate("VAT", "-1", false)
+	assert.NotNil(t, err)
+}
+
+func TestComputeInvoice(t *testing.T) {
+	vat := MustForgeRate("VAT", "22", false)
+	lines := []Line{
+		{money.EUR(10), []Rate{vat}},
+		{money.EUR(10), []Rate{vat}},
+		{money.EUR(10), []Rate{vat}},
+	}
+
+	perLine, err := ComputeInvoice(lines, false, RoundPerLine, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(6), perLine.Tax())
+	assert.Equal(t, money.EUR(36), perLine.Gross)
+	sumsUp(t, perLine.Breakdown)
+
+	perInvoice, err := ComputeInvoice(lines, false, RoundPerInvoice, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(7), perInvoice.Tax())
+	assert.Equal(t, money.EUR(37), perInvoice.Gross)
+	assert.Len(t, perInvoice.Lines, 3)
+	sumsUp(t, perInvoice.Breakdown)
+
+	inclusive, err := ComputeInvoice(lines, true, RoundPerInvoice, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, money.EUR(30), inclusive.Gross)
+	assert.Equal(t, money.EUR(5), inclusive.Tax())
+	sumsUp(t, inclusive.Breakdown)
+
+	_, err = ComputeInvoice([]Line{{money.EUR(10), nil}, {money.USD(10), nil}}, false, RoundPerLine, money.RoundHalfEven)
+	assert.NotNil(t, err)
+
+	_, err = ComputeInvoice(nil, false, RoundPerLine, money.RoundHalfEven)
+	assert.NotNil(t, err)
+}
+
+func TestComputeInvoice_sameNameDifferentRates(t *testing.T) {
+	lines := []Line{
+		{money.EUR(1000), []Rate{MustForgeRate("VAT", "22", false)}},
+		{money.EUR(1000), []Rate{MustForgeRate("VAT", "10", false)}},
+		{money.EUR(1000), []Rate{MustForgeRate("VAT", "22", false)}},
+	}
+
+	inv, err := ComputeInvoice(lines, false, RoundPerInvoice, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Len(t, inv.Taxes, 2)
+	assert.Equal(t, "22", inv.Taxes[0].Rate.Percent.RatString())
+	assert.Equal(t, money.EUR(440), inv.Taxes[0].Amount)
+	assert.Equal(t, "10", inv.Taxes[1].Rate.Percent.RatString())
+	assert.Equal(t, money.EUR(100), inv.Taxes[1].Amount)
+	sumsUp(t, inv.Breakdown)
+}
+
+func TestExclusive_isolatedRegistry(t *testing.T) {
+	r := money.MustNewRegistry(money.Currency{Code: "PTS", MinorUnit: 0})
+
+	b, err := Exclusive(r.MustForge(1000, "PTS"), money.RoundHalfEven, MustForgeRate("VAT", "22", false))
+	assert.Nil(t, err)
+	assert.Equal(t, r.MustForge(1220, "PTS"), b.Gross)
+	sumsUp(t, b)
+
+	inv, err := ComputeInvoice([]Line{{r.MustForge(1000, "PTS"), []Rate{MustForgeRate("VAT", "22", false)}}}, true, RoundPerInvoice, money.RoundHalfEven)
+	assert.Nil(t, err)
+	assert.Equal(t, "PTS", inv.Tax().Currency.Code)
+}
+
+func TestRate_JSON(t *testing.T) {
+	gst := MustForgeRate("GST", "5", false)
+	qst := MustForgeRate("QST", "9.975", false)
+	b := MustExclusive(money.CAD(10000), money.RoundHalfEven, gst, qst)
+
+	data, err := json.Marshal(&b)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"rate":{"name":"GST","percent":"5","compound":false}`)
+	assert.Contains(t, string(data), `"rate":{"name":"QST","percent":"9.975","compound":false}`)
+
+	got := Breakdown{}
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, b.Net, got.Net)
+	assert.Equal(t, b.Gross, got.Gross)
+	assert.Len(t, got.Taxes, 2)
+	for i, tx := range got.Taxes {
+		assert.Equal(t, b.Taxes[i].Rate.key(), tx.Rate.key())
+		assert.Equal(t, b.Taxes[i].Amount, tx.Amount)
+	}
+
+	third := MustForgeRate("X", "1/3", false)
+	data, err = json.Marshal(third)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"X","percent":"1/3","compound":false}`, string(data))
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"name":"X","percent":"abc"}`), &third))
+}